@@ -12,6 +12,7 @@
 package unilog
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-the-way/unilog/internal/logger"
@@ -22,44 +23,110 @@ type CallbackFunc func(req *LogAddReq)
 
 // Callback generates a logging function for a given Logger type, applying optional callback functions.
 // It constructs a log entry using the Logger's name, fields, user data, and client IP, then passes it to LogAdd.
-// The optional callback function can modify the LogAddReq before it is logged.
+// The optional callback functions can modify the LogAddReq before it is logged, and are applied in order.
+// If LogAdd fails, the error is passed to the handler set through SetErrorHandler.
 func Callback[LOG logger.Logger](opts ...CallbackFunc) func(req LOG) {
-	return func(req LOG) {
-		// Get the log name, defaulting to "unknown" if not provided.
-		logName := req.LogName()
-		if logName == "" {
-			logName = "unknown"
-		}
+	callback := std.Callback(opts...)
+	return func(req LOG) { callback(req) }
+}
 
-		// Generate the fields content by logging the fields slice, if any.
-		fieldsContent := ""
-		if len(req.LogFields()) > 0 {
-			fieldsContent = req.LogFields().Log()
-		}
+// CallbackE is like Callback, but the generated logging function returns the error from LogAdd.
+func CallbackE[LOG logger.Logger](opts ...CallbackFunc) func(req LOG) error {
+	callbackE := std.CallbackE(opts...)
+	return func(req LOG) error { return callbackE(req) }
+}
+
+// CallbackCtx is like Callback, but the generated logging function accepts a context, which is passed to LogAddCtx.
+// User data and client IP left empty by the Logger are retrieved from the context.
+func CallbackCtx[LOG logger.Logger](opts ...CallbackFunc) func(ctx context.Context, req LOG) {
+	callbackCtx := std.CallbackCtx(opts...)
+	return func(ctx context.Context, req LOG) { callbackCtx(ctx, req) }
+}
 
-		// Retrieve user data and client IP from the Logger.
-		userdata := req.LogUser()
-		clientIP := req.LogClientIP()
+// CallbackCtxE is like CallbackCtx, but the generated logging function returns the error from LogAddCtx.
+func CallbackCtxE[LOG logger.Logger](opts ...CallbackFunc) func(ctx context.Context, req LOG) error {
+	callbackCtxE := std.CallbackCtxE(opts...)
+	return func(ctx context.Context, req LOG) error { return callbackCtxE(ctx, req) }
+}
+
+// Callback generates a logging function for any Logger using the instance, like the package-level Callback.
+func (u *Unilog) Callback(opts ...CallbackFunc) func(req Logger) {
+	callbackCtx := u.CallbackCtx(opts...)
+	return func(req Logger) { callbackCtx(context.Background(), req) }
+}
 
-		// Construct the log content by combining the log name and fields content.
-		content := fmt.Sprintf("%s{%s}", logName, fieldsContent)
+// CallbackE is like Callback, but the generated logging function returns the error from LogAdd.
+func (u *Unilog) CallbackE(opts ...CallbackFunc) func(req Logger) error {
+	callbackCtxE := u.CallbackCtxE(opts...)
+	return func(req Logger) error { return callbackCtxE(context.Background(), req) }
+}
 
-		// Create a LogAddReq instance with user data, client IP, and content.
-		req0 := LogAddReq{
-			UserId:   userdata.UserId,
-			UserName: userdata.UserName,
-			ClientIP: clientIP,
-			Content:  content,
+// CallbackCtx is like Callback, but the generated logging function accepts a context, which is passed to LogAddCtx.
+func (u *Unilog) CallbackCtx(opts ...CallbackFunc) func(ctx context.Context, req Logger) {
+	return func(ctx context.Context, req Logger) {
+		req0 := u.newLogAddReq(ctx, req, opts...)
+		if err := u.svc.AddCtx(ctx, req0); err != nil {
+			u.svc.HandleError(err, req0)
 		}
+	}
+}
 
-		// Apply the optional callback function to modify the LogAddReq, if provided.
-		if len(opts) > 0 {
-			if opt := opts[0]; opt != nil {
-				opt(&req0)
-			}
+// CallbackCtxE is like CallbackCtx, but the generated logging function returns the error from LogAddCtx.
+func (u *Unilog) CallbackCtxE(opts ...CallbackFunc) func(ctx context.Context, req Logger) error {
+	return func(ctx context.Context, req Logger) error {
+		return u.svc.AddCtx(ctx, u.newLogAddReq(ctx, req, opts...))
+	}
+}
+
+// newLogAddReq constructs a LogAddReq from the Logger's name, fields, user data, and client IP,
+// falling back to the context for empty user data and client IP,
+// then applies the optional callback functions to it in order.
+func (u *Unilog) newLogAddReq(ctx context.Context, req Logger, opts ...CallbackFunc) LogAddReq {
+	// Get the log name, defaulting to "unknown" if not provided.
+	logName := req.LogName()
+	if logName == "" {
+		logName = "unknown"
+	}
+
+	// Generate the fields content by logging the fields slice, if any,
+	// using the Logger's own Renderer if it implements LogRenderer, or else the instance's Renderer.
+	fields := req.LogFields()
+	fieldsContent := ""
+	if len(fields) > 0 {
+		renderer := u.renderer
+		if lr, ok := req.(logger.LogRenderer); ok && lr.LogRenderer() != nil {
+			renderer = lr.LogRenderer()
+		}
+		if renderer != nil {
+			fieldsContent = fields.Render(renderer)
+		} else {
+			fieldsContent = fields.Log()
 		}
+	}
+
+	// Retrieve user data and client IP from the Logger, or from the context if empty.
+	userdata := req.LogUser()
+	if userdata == (Userdata{}) {
+		userdata = u.userdataFromContext(ctx)
+	}
+	clientIP := req.LogClientIP()
+	if clientIP == "" {
+		clientIP = u.clientIPFromContext(ctx)
+	}
+
+	// Construct the log content by combining the log name and fields content.
+	content := fmt.Sprintf("%s{%s}", logName, fieldsContent)
 
-		// Pass the constructed LogAddReq to the LogAdd function for logging.
-		LogAdd(req0)
+	// Create a LogAddReq instance with user data, client IP, content, and fields.
+	req0 := LogAddReq{
+		UserId:   userdata.UserId,
+		UserName: userdata.UserName,
+		ClientIP: clientIP,
+		Content:  content,
+		Fields:   fields,
 	}
+
+	// Apply the optional callback functions to modify the LogAddReq, if provided.
+	Chain(opts...)(&req0)
+	return req0
 }
@@ -0,0 +1,126 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unilog_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-the-way/unilog"
+)
+
+// order is a Logger logged by the callback tests.
+type order struct {
+	Id     uint   `log:"id"`
+	Status string `log:"status"`
+}
+
+func (o order) LogName() string              { return "order" }
+func (o order) LogFields() unilog.FieldSlice { return unilog.GetFields(o) }
+func (o order) LogUser() unilog.Userdata     { return unilog.Userdata{UserId: 7, UserName: "Kellen"} }
+func (o order) LogClientIP() string          { return "10.0.0.1" }
+
+// failingStore is a Store whose Add always fails with errStore.
+type failingStore struct {
+	unilog.Store
+}
+
+// errStore is the error returned by failingStore.
+var errStore = errors.New("store unavailable")
+
+func (failingStore) Add(context.Context, *unilog.Log) error { return errStore }
+
+// useStore makes unilog use a Store until the test ends.
+func useStore(t *testing.T, st unilog.Store) {
+	prev := unilog.GetStore()
+	unilog.SetStore(st)
+	t.Cleanup(func() { unilog.SetStore(prev) })
+}
+
+func TestCallbackE(t *testing.T) {
+	useStore(t, unilog.NewMemoryStore())
+	if err := unilog.CallbackE[order]()(order{1, "paid"}); err != nil {
+		t.Fatalf("CallbackE() = %v", err)
+	}
+	resp, err := unilog.LogGetPage(unilog.LogGetPageReq{})
+	if err != nil || len(resp.List) != 1 {
+		t.Fatalf("LogGetPage() = %+v, %v, want one entry", resp, err)
+	}
+	got := resp.List[0]
+	if got.Content != "order{id[1],status[paid]}" || got.UserId != 7 || got.UserName != "Kellen" || got.ClientIP != "10.0.0.1" {
+		t.Fatalf("logged entry = %+v", got)
+	}
+
+	useStore(t, failingStore{})
+	if err = unilog.CallbackE[order]()(order{2, "paid"}); !errors.Is(err, errStore) {
+		t.Fatalf("CallbackE() = %v, want %v", err, errStore)
+	}
+}
+
+func TestCallbackErrorHandler(t *testing.T) {
+	useStore(t, failingStore{})
+	var handled []unilog.LogAddReq
+	unilog.SetErrorHandler(func(err error, req unilog.LogAddReq) {
+		if errors.Is(err, errStore) {
+			handled = append(handled, req)
+		}
+	})
+	defer unilog.SetErrorHandler(nil)
+
+	unilog.Callback[order]()(order{1, "paid"})
+	if len(handled) != 1 || handled[0].Content != "order{id[1],status[paid]}" {
+		t.Fatalf("handled requests = %+v, want the failed request", handled)
+	}
+}
+
+// anonymousOrder is a Logger without user data or client IP, which are taken from the context.
+type anonymousOrder struct {
+	Id uint `log:"id"`
+}
+
+func (o anonymousOrder) LogName() string              { return "order" }
+func (o anonymousOrder) LogFields() unilog.FieldSlice { return unilog.GetFields(o) }
+func (o anonymousOrder) LogUser() unilog.Userdata     { return unilog.Userdata{} }
+func (o anonymousOrder) LogClientIP() string          { return "" }
+
+func TestCallbackCtxPropagation(t *testing.T) {
+	useStore(t, unilog.NewMemoryStore())
+	ctx := unilog.ContextWithUserdata(context.Background(), unilog.Userdata{UserId: 3, UserName: "Polo"})
+	ctx = unilog.ContextWithClientIP(ctx, "192.168.1.2")
+
+	if err := unilog.CallbackCtxE[anonymousOrder]()(ctx, anonymousOrder{1}); err != nil {
+		t.Fatalf("CallbackCtxE() = %v", err)
+	}
+	// The Logger's own user data and client IP take precedence over the context.
+	if err := unilog.CallbackCtxE[order]()(ctx, order{2, "paid"}); err != nil {
+		t.Fatalf("CallbackCtxE() = %v", err)
+	}
+
+	resp, err := unilog.LogGetPage(unilog.LogGetPageReq{OrderBy: "id"})
+	if err != nil || len(resp.List) != 2 {
+		t.Fatalf("LogGetPage() = %+v, %v, want two entries", resp, err)
+	}
+	if got := resp.List[0]; got.UserId != 3 || got.UserName != "Polo" || got.ClientIP != "192.168.1.2" {
+		t.Fatalf("entry logged with context = %+v", got)
+	}
+	if got := resp.List[1]; got.UserId != 7 || got.ClientIP != "10.0.0.1" {
+		t.Fatalf("entry logged with Logger data = %+v", got)
+	}
+
+	// A cancelled context stops the entry from being added.
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err = unilog.CallbackCtxE[anonymousOrder]()(cancelled, anonymousOrder{3}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("CallbackCtxE() with a cancelled context = %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,19 @@
+module github.com/go-the-way/unilog/cmd/unilogvet
+
+go 1.25.0
+
+require (
+	github.com/go-the-way/unilog v0.0.0
+	golang.org/x/tools v0.44.0
+)
+
+require (
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
+	golang.org/x/mod v0.35.0 // indirect
+	golang.org/x/sync v0.20.0 // indirect
+	golang.org/x/text v0.20.0 // indirect
+	gorm.io/gorm v1.30.0 // indirect
+)
+
+replace github.com/go-the-way/unilog => ../..
@@ -0,0 +1,163 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package logtag provides an analyzer that checks unilog `log` struct tags,
+// applying the same rules as unilog.Validate at compile time.
+package logtag
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/go-the-way/unilog"
+)
+
+// Analyzer reports unknown options, unresolvable ref paths, malformed transform mappings,
+// and formats with the wrong number of placeholders in `log` struct tags.
+var Analyzer = &analysis.Analyzer{
+	Name:     "logtag",
+	Doc:      "check that unilog `log` struct tags are well formed",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// run checks the log tags of every struct type in the package.
+func run(pass *analysis.Pass) (any, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	// Type specs are visited before their struct types, so names are recorded first.
+	names := map[ast.Expr]string{}
+	insp.Preorder([]ast.Node{(*ast.TypeSpec)(nil), (*ast.StructType)(nil)}, func(n ast.Node) {
+		if ts, ok := n.(*ast.TypeSpec); ok {
+			names[ts.Type] = ts.Name.Name
+			return
+		}
+		st, ok := pass.TypesInfo.TypeOf(n.(*ast.StructType)).(*types.Struct)
+		if !ok {
+			return
+		}
+		name := names[n.(*ast.StructType)]
+		if name == "" {
+			name = "struct"
+		}
+		for _, field := range n.(*ast.StructType).Fields.List {
+			checkField(pass, st, name, field)
+		}
+	})
+	return nil, nil
+}
+
+// checkField checks the log tag of a struct field declaration, which may declare several names.
+// The name of the struct type is used in messages about ref paths.
+func checkField(pass *analysis.Pass, st *types.Struct, stName string, field *ast.Field) {
+	if field.Tag == nil {
+		return
+	}
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return
+	}
+	logTag, ok := reflect.StructTag(tag).Lookup("log")
+	if !ok || logTag == "-" {
+		return
+	}
+
+	// Collect the declared names, using the type name for embedded fields.
+	var names []*ast.Ident
+	if len(field.Names) > 0 {
+		names = field.Names
+	} else if id := embeddedIdent(field.Type); id != nil {
+		names = []*ast.Ident{id}
+	}
+
+	for _, name := range names {
+		if !name.IsExported() {
+			pass.Reportf(field.Tag.Pos(), "log tag %q: unexported field %s is never logged", logTag, name.Name)
+			continue
+		}
+		isStruct := false
+		if obj, ok := pass.TypesInfo.Defs[name].(*types.Var); ok {
+			isStruct = isStructType(obj.Type())
+		} else if t := pass.TypesInfo.TypeOf(field.Type); t != nil {
+			isStruct = isStructType(t)
+		}
+		for _, msg := range unilog.CheckTag(logTag, isStruct, refResolver(pass.Pkg, st, stName)) {
+			pass.Reportf(field.Tag.Pos(), "log tag %q: %s", logTag, msg)
+		}
+	}
+}
+
+// embeddedIdent returns the type name identifier of an embedded field, or nil if unknown.
+func embeddedIdent(expr ast.Expr) *ast.Ident {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e
+	case *ast.StarExpr:
+		return embeddedIdent(e.X)
+	case *ast.SelectorExpr:
+		return e.Sel
+	case *ast.IndexExpr:
+		return embeddedIdent(e.X)
+	}
+	return nil
+}
+
+// isStructType reports whether a type is a struct or a pointer to a struct.
+func isStructType(t types.Type) bool {
+	if ptr, ok := t.Underlying().(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	_, ok := t.Underlying().(*types.Struct)
+	return ok
+}
+
+// refResolver returns a function that checks a ref path against a struct type,
+// mirroring the reflection rules of unilog: intermediate fields must be structs, not pointers,
+// and the target field must be exported.
+func refResolver(pkg *types.Package, st *types.Struct, stName string) func(path string) error {
+	// typeString names the struct by its declared name, and other types relative to the package.
+	typeString := func(t types.Type) string {
+		if t == st {
+			return stName
+		}
+		return types.TypeString(t, types.RelativeTo(pkg))
+	}
+	return func(path string) error {
+		var t types.Type = st
+		segS := strings.Split(strings.TrimPrefix(path, "."), ".")
+		for i, seg := range segS {
+			if seg == "" {
+				return errors.New("empty path segment")
+			}
+			if _, ok := t.Underlying().(*types.Struct); !ok {
+				return fmt.Errorf("%s is of type %s, not a struct", strings.Join(segS[:i], "."), typeString(t))
+			}
+			obj, _, _ := types.LookupFieldOrMethod(t, false, pkg, seg)
+			fd, ok := obj.(*types.Var)
+			if !ok || !fd.IsField() {
+				return fmt.Errorf("no field %s in %s", seg, typeString(t))
+			}
+			if i == len(segS)-1 && !fd.Exported() {
+				return fmt.Errorf("field %s of %s is unexported", seg, typeString(t))
+			}
+			t = fd.Type()
+		}
+		return nil
+	}
+}
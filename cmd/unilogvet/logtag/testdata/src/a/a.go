@@ -0,0 +1,58 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package a
+
+import "time"
+
+type inner struct {
+	Name string
+}
+
+type valid struct {
+	Id       uint          `log:"id"`
+	Status   int           `log:"status,transform:1,2->on|3..9->off|*->unknown"`
+	Level    int           `log:"level,transform:@level"`
+	Kind     int           `log:"kind,transform:enum"`
+	Phone    string        `log:"phone,mask:phone"`
+	Card     string        `log:"card,mask:keep(3,4)"`
+	Secret   string        `log:"secret,redact"`
+	Digest   string        `log:"digest,hash:sha256"`
+	UserKey  string        `log:"user_key,hash:hmac-sha256"`
+	Code     int           `log:"code,stringer"`
+	Created  time.Time     `log:"created,time:2006-01-02,tz:Asia/Shanghai"`
+	Updated  time.Time     `log:"updated,time:rfc3339,omitzero"`
+	Elapsed  time.Duration `log:"elapsed,duration:ms"`
+	Remark   string        `log:"remark,omitempty"`
+	Owner    string        `log:"owner,ref:Inner.Name"`
+	Inner    inner         `log:"inner"`
+	Embedded inner         `log:",inline"`
+	Ignored  string        `log:"-"`
+	hidden   string
+}
+
+type invalid struct {
+	Status  int           `log:"status,transform:1->on|1->off"` // want `log tag "status,transform:1->on\|1->off": transform "1->on\|1->off": duplicate key "1"`
+	Owner   string        `log:"owner,ref:Inner.Missing"`       // want `log tag "owner,ref:Inner.Missing": ref "Inner.Missing": no field Missing in inner`
+	Created time.Time     `log:"created,tz:Mars/Olympus"`       // want `log tag "created,tz:Mars/Olympus": unknown time zone "Mars/Olympus"`
+	Elapsed time.Duration `log:"elapsed,duration:weeks"`        // want `unknown duration mode "weeks"`
+	Digest  string        `log:"digest,hash:crc32"`             // want `unknown hash algorithm "crc32"`
+	Remark  string        `log:"remark,omitempty,omitzero"`     // want `conflicting options omitempty and omitzero`
+	Format  string        `log:"format,%s"`                     // want `format "%s" has 1 placeholders, need 2 for name and value`
+	Option  string        `log:"option,colour"`                 // want `unknown option "colour"`
+	Inline  string        `log:",inline"`                       // want `inline requires a struct field`
+	secret  string        `log:"secret"`                        // want `unexported field secret is never logged`
+	Inner   inner
+	// The problem is reported at the tag, not at the start of the field.
+	Anon struct {
+		A int
+	} `log:"anon,colour"` // want `unknown option "colour"`
+}
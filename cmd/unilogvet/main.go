@@ -0,0 +1,26 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command unilogvet checks unilog `log` struct tags.
+// It can be run directly, or as a vet tool:
+//
+//	go vet -vettool=$(which unilogvet) ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/go-the-way/unilog/cmd/unilogvet/logtag"
+)
+
+func main() {
+	singlechecker.Main(logtag.Analyzer)
+}
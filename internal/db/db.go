@@ -12,6 +12,9 @@
 package db
 
 import (
+	"context"
+	"sync"
+
 	"github.com/go-the-way/unilog/internal/models"
 	"gorm.io/gorm"
 )
@@ -20,25 +23,62 @@ import (
 type PaginationFunc func(db *gorm.DB, page, limit int, count *int64, list any) (err error)
 
 var (
+	mu       sync.RWMutex // mu guards gdb and pageFunc against concurrent configuration and use.
 	gdb      *gorm.DB
 	pageFunc PaginationFunc
 )
 
 // SetDB sets the global database instance.
-func SetDB(db *gorm.DB) { gdb = db }
+func SetDB(db *gorm.DB) {
+	mu.Lock()
+	defer mu.Unlock()
+	gdb = db
+}
 
 // GetDB retrieves the global database instance.
-func GetDB() *gorm.DB { return gdb }
+func GetDB() *gorm.DB {
+	mu.RLock()
+	defer mu.RUnlock()
+	return gdb
+}
+
+// txKey is the context key for a caller's database transaction.
+type txKey struct{}
+
+// ContextWithTx returns a copy of the context carrying the given database transaction,
+// so that log entries are written inside the caller's transaction.
+func ContextWithTx(ctx context.Context, tx *gorm.DB) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// TxFromContext retrieves the database transaction carried by the context, if any.
+func TxFromContext(ctx context.Context) (tx *gorm.DB, ok bool) {
+	tx, ok = ctx.Value(txKey{}).(*gorm.DB)
+	return tx, ok && tx != nil
+}
 
 // SetPagination sets the pagination function for database queries.
-func SetPagination(paginationFunc PaginationFunc) { pageFunc = paginationFunc }
+func SetPagination(paginationFunc PaginationFunc) {
+	mu.Lock()
+	defer mu.Unlock()
+	pageFunc = paginationFunc
+}
 
 // GetPagination retrieves the pagination function.
-func GetPagination() PaginationFunc { return pageFunc }
+func GetPagination() PaginationFunc {
+	mu.RLock()
+	defer mu.RUnlock()
+	return pageFunc
+}
 
 // AutoMigrate automatically migrates the database schema for the Log model.
 func AutoMigrate() (err error) {
-	return gdb.AutoMigrate(
+	return Migrate(GetDB())
+}
+
+// Migrate automatically migrates the schema of the given database for the Log model.
+func Migrate(db *gorm.DB) (err error) {
+	return db.AutoMigrate(
 		new(models.Log),
 	)
 }
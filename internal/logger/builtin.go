@@ -0,0 +1,53 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"reflect"
+	"time"
+)
+
+// Built-in types with dedicated rendering.
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+	errorType    = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// defaultTimeLayout is the layout of time.Time values without a "time:<layout>" tag option.
+const defaultTimeLayout = "2006-01-02 15:04:05"
+
+// isBuiltinScalar reports whether a type is logged as a single value regardless of its kind,
+// such as time.Time, which is not recursed into as a struct, and error, which is logged despite being an interface.
+func isBuiltinScalar(t reflect.Type) bool {
+	return t == timeType || t == errorType
+}
+
+// builtinValue converts a value of a built-in type to the value that is logged:
+// time.Time with the default layout, time.Duration in its string form (e.g., "1m30s"),
+// and error by its message, or nil if the error is nil or a nil pointer. The ok result is false for other types.
+// Use scalarValue to also honor registered formatters and LogValuer.
+func builtinValue(sv reflect.Value) (v any, ok bool) {
+	switch sv.Type() {
+	case timeType:
+		return sv.Interface().(time.Time).Format(defaultTimeLayout), true
+	case durationType:
+		return time.Duration(sv.Int()).String(), true
+	case errorType:
+		// A nil pointer error is not a nil interface, but calling Error on it may panic.
+		if isNil(sv) {
+			return nil, true
+		}
+		return sv.Interface().(error).Error(), true
+	}
+	return
+}
@@ -0,0 +1,44 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"errors"
+	"testing"
+)
+
+// ptrError is an error of a pointer type, whose Error method panics on a nil receiver.
+type ptrError struct {
+	msg string
+}
+
+func (e *ptrError) Error() string { return e.msg }
+
+func TestGetFieldsNilPointerError(t *testing.T) {
+	type result struct {
+		Err    error   `log:"err"`
+		Errs   []error `log:"errs"`
+		Extra  any     `log:"extra"`
+		Reason error   `log:"reason"`
+	}
+	var nilErr *ptrError
+	r := result{Err: nilErr, Errs: []error{nilErr, errors.New("failed")}, Extra: nilErr, Reason: &ptrError{"denied"}}
+
+	want := "err[<nil>],errs[<nil>,failed],extra[<nil>],reason[denied]"
+	if got := GetFields(r).Log(); got != want {
+		t.Fatalf("GetFields().Log() = %q, want %q", got, want)
+	}
+	wantJSON := `{"err":null,"errs":[null,"failed"],"extra":null,"reason":"denied"}`
+	if got := GetFields(r).JSON(); got != wantJSON {
+		t.Fatalf("GetFields().JSON() = %s, want %s", got, wantJSON)
+	}
+}
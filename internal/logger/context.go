@@ -0,0 +1,80 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import "context"
+
+// Context keys for request-scoped logging data.
+type (
+	userdataKey struct{}
+	clientIPKey struct{}
+)
+
+// UserdataFunc defines a function type for retrieving user data from a context.
+type UserdataFunc func(ctx context.Context) (userdata Userdata)
+
+// ClientIPFunc defines a function type for retrieving the client IP address from a context.
+type ClientIPFunc func(ctx context.Context) (clientIP string)
+
+// Package-level variables for retrieving logging data from a context, guarded by settingsMu.
+var (
+	// userdataFunc0 retrieves user data from a context, defaulting to the one stored by ContextWithUserdata.
+	userdataFunc0 UserdataFunc = func(ctx context.Context) (userdata Userdata) {
+		userdata, _ = ctx.Value(userdataKey{}).(Userdata)
+		return
+	}
+	// clientIPFunc0 retrieves the client IP address from a context, defaulting to the one stored by ContextWithClientIP.
+	clientIPFunc0 ClientIPFunc = func(ctx context.Context) (clientIP string) {
+		clientIP, _ = ctx.Value(clientIPKey{}).(string)
+		return
+	}
+)
+
+// ContextWithUserdata returns a copy of the context carrying the given user data.
+func ContextWithUserdata(ctx context.Context, userdata Userdata) context.Context {
+	return context.WithValue(ctx, userdataKey{}, userdata)
+}
+
+// ContextWithClientIP returns a copy of the context carrying the given client IP address.
+func ContextWithClientIP(ctx context.Context, clientIP string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, clientIP)
+}
+
+// SetUserdataFunc sets a custom function for retrieving user data from a context.
+func SetUserdataFunc(userdataFunc UserdataFunc) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	userdataFunc0 = userdataFunc
+}
+
+// SetClientIPFunc sets a custom function for retrieving the client IP address from a context.
+func SetClientIPFunc(clientIPFunc ClientIPFunc) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	clientIPFunc0 = clientIPFunc
+}
+
+// UserdataFromContext retrieves user data from a context using the configured UserdataFunc.
+func UserdataFromContext(ctx context.Context) (userdata Userdata) {
+	settingsMu.RLock()
+	userdataFunc := userdataFunc0
+	settingsMu.RUnlock()
+	return userdataFunc(ctx)
+}
+
+// ClientIPFromContext retrieves the client IP address from a context using the configured ClientIPFunc.
+func ClientIPFromContext(ctx context.Context) (clientIP string) {
+	settingsMu.RLock()
+	clientIPFunc := clientIPFunc0
+	settingsMu.RUnlock()
+	return clientIPFunc(ctx)
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// GetDiff compares two structs of the same type and extracts only the fields whose logged value has changed.
+// Fields are selected with the same log tag rules as GetFields, and each changed field is rendered with
+// the diff format by default (e.g., "Status[on=>off]"). Nested structs keep only their changed fields.
+// Panics if either input is invalid, not a struct, or the two structs are of different types.
+func GetDiff(oldStruct, newStruct any, defaultIgnore ...bool) (fieldSlice FieldSlice) {
+	// Validate both input values and ensure they're valid reflect.Values.
+	ov, nv := reflect.ValueOf(oldStruct), reflect.ValueOf(newStruct)
+	if !ov.IsValid() || !nv.IsValid() {
+		panic("unilog: the struct value is invalid.")
+	}
+
+	// Dereference pointers and ensure both values are structs of the same type.
+	if ov, nv = rv(ov), rv(nv); ov.Kind() != reflect.Struct || nv.Kind() != reflect.Struct {
+		panic("unilog: the struct value is not supported.")
+	}
+	if ov.Type() != nv.Type() {
+		panic("unilog: the struct types are different.")
+	}
+
+	// Delegate to getDiffFields to compare the struct fields.
+	return getDiffFields(ov, nv, defaultIgnore...)
+}
+
+// getDiffFields walks two structs of the same type in parallel and extracts the changed fields.
+// It applies the same compiled plan as getSupportedFields, recursing into nested and inline structs.
+func getDiffFields(ov, nv reflect.Value, defaultIgnoreS ...bool) (fieldSlice FieldSlice) {
+	// Determine the default ignore behavior for fields without log tags.
+	var defaultIgnore bool
+	if len(defaultIgnoreS) > 0 {
+		defaultIgnore = defaultIgnoreS[0]
+	}
+
+	for _, fp := range getStructPlan(ov.Type(), defaultIgnore).fields {
+		// Get the dereferenced old and new field values, either of which may be a nil pointer,
+		// unwrapping interface fields to their dynamic values.
+		osv, oNested := fp.unwrap(rv(ov.Field(fp.index)))
+		nsv, nNested := fp.unwrap(rv(nv.Field(fp.index)))
+		if !osv.IsValid() && !nsv.IsValid() {
+			continue
+		}
+
+		// Compare nested and inline structs field by field when both sides are present and of the same type.
+		if (fp.inline || oNested && nNested) && isStruct0(osv) && isStruct0(nsv) && osv.Type() == nsv.Type() {
+			inner := getDiffFields(osv, nsv, defaultIgnore)
+			if len(inner) == 0 {
+				continue
+			}
+			if fp.inline {
+				fieldSlice = append(fieldSlice, inner...)
+			} else {
+				fieldSlice = append(fieldSlice, Field{Name: fp.name, Format: fp.format, expr: newExprFields(inner), SV: nsv, OV: nv})
+			}
+			continue
+		}
+
+		// Build the old and new fields, rendering nested structs or arrays/slices of structs as a whole.
+		of := Field{Name: fp.name, Format: fp.format, expr: fp.expr, SV: osv, OV: ov}
+		nf := Field{Name: fp.name, Format: fp.format, expr: fp.expr, SV: nsv, OV: nv}
+		if fp.inline || oNested {
+			of.expr = newExprFields(getSupportedFields(osv, defaultIgnore))
+		}
+		if fp.inline || nNested {
+			nf.expr = newExprFields(getSupportedFields(nsv, defaultIgnore))
+		}
+
+		// Keep the field only if its logged value has changed.
+		// Masked fields are compared by their raw values, since different values may be masked alike.
+		oldValue, newValue := of.diffValue(), nf.diffValue()
+		changed := fmt.Sprint(oldValue) != fmt.Sprint(newValue)
+		if _, masked := fp.expr.(maskExpr); masked {
+			changed = fmt.Sprint(of.value()) != fmt.Sprint(nf.value())
+		}
+		if changed {
+			fieldSlice = append(fieldSlice, Field{Name: fp.name, expr: newExprDiff(oldValue, newValue), SV: nsv, OV: nv})
+		}
+	}
+	return
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import "testing"
+
+// diffAddress is a nested struct compared field by field in GetDiff tests.
+type diffAddress struct {
+	City   string `log:"city"`
+	Street string `log:"street"`
+}
+
+// diffUser is a struct exercising plain, transformed, nested and inline fields in GetDiff tests.
+type diffUser struct {
+	Name    string      `log:"name"`
+	Status  int         `log:"status,transform:1->on|2->off"`
+	Address diffAddress `log:"address"`
+	Extra   diffAddress `log:",inline"`
+	Ignored string      `log:"-"`
+}
+
+func TestGetDiff(t *testing.T) {
+	old := diffUser{Name: "Kellen", Status: 1, Address: diffAddress{"Paris", "Main"}, Extra: diffAddress{"Rome", "Via"}}
+	tests := []struct {
+		name   string
+		update func(u *diffUser)
+		want   string
+	}{
+		{"unchanged", func(u *diffUser) { u.Ignored = "x" }, ""},
+		{"plain", func(u *diffUser) { u.Name = "Polo" }, "name[Kellen=>Polo]"},
+		{"transformed", func(u *diffUser) { u.Status = 2 }, "status[on=>off]"},
+		{"nested", func(u *diffUser) { u.Address.City = "Lyon" }, "address[city[Paris=>Lyon]]"},
+		{"inline", func(u *diffUser) { u.Extra.Street = "Corso" }, "street[Via=>Corso]"},
+		{"several", func(u *diffUser) { u.Name, u.Status = "Polo", 2 }, "name[Kellen=>Polo],status[on=>off]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := old
+			tt.update(&u)
+			if got := GetDiff(old, &u).Log(); got != tt.want {
+				t.Fatalf("GetDiff().Log() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetDiffPanics(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new any
+	}{
+		{"nil", nil, diffUser{}},
+		{"not a struct", 1, 2},
+		{"different types", diffUser{}, diffAddress{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Fatal("GetDiff() did not panic")
+				}
+			}()
+			GetDiff(tt.old, tt.new)
+		})
+	}
+}
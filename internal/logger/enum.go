@@ -0,0 +1,39 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import "reflect"
+
+// enums is the registry of enum tables by type, referenced by the "transform:enum" tag option and guarded by settingsMu.
+var enums = map[reflect.Type]map[any]string{}
+
+// RegisterEnum registers the labels of an enum type, to be referenced by the "transform:enum" tag option
+// on fields of type T or *T, so that translations live in Go code instead of being repeated in tags.
+// Registering a type again replaces its previous table.
+func RegisterEnum[T comparable](table map[T]string) {
+	// Copy the table, so that later changes by the caller do not race with logging.
+	m := make(map[any]string, len(table))
+	for k, v := range table {
+		m[k] = v
+	}
+
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	enums[reflect.TypeOf((*T)(nil)).Elem()] = m
+}
+
+// getEnum retrieves the registered enum table of a type, returning nil if none is registered.
+func getEnum(typ reflect.Type) map[any]string {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return enums[typ]
+}
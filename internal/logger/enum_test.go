@@ -0,0 +1,81 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"reflect"
+	"testing"
+)
+
+// enumStatus is an enum type implementing fmt.Stringer in tests.
+type enumStatus int
+
+// String returns the label of the status.
+func (s enumStatus) String() string {
+	if s == 1 {
+		return "Active"
+	}
+	return "Inactive"
+}
+
+// enumColor is an enum type implementing encoding.TextMarshaler with a pointer receiver in tests.
+type enumColor int
+
+// MarshalText returns the label of the color.
+func (c *enumColor) MarshalText() ([]byte, error) {
+	return []byte([]string{"red", "green"}[*c]), nil
+}
+
+// enumLevel is an enum type with a registered enum table in tests.
+type enumLevel int
+
+// enumReq is a struct exercising the stringer and transform:enum tag options in tests.
+type enumReq struct {
+	Status   enumStatus `log:"status,stringer"`
+	Verbose  enumStatus `log:"verbose,stringer,%s[%s self:%d]"`
+	Color    enumColor  `log:"color,stringer"`
+	Count    int        `log:"count,stringer"`
+	Level    enumLevel  `log:"level,transform:enum"`
+	Unknown  enumLevel  `log:"unknown,transform:enum"`
+	LevelPtr *enumLevel `log:"level_ptr,transform:enum"`
+}
+
+// useEnum registers the enum table of enumLevel for the duration of the test.
+func useEnum(t *testing.T) {
+	RegisterEnum(map[enumLevel]string{1: "debug", 2: "info"})
+	t.Cleanup(func() {
+		settingsMu.Lock()
+		defer settingsMu.Unlock()
+		delete(enums, reflect.TypeOf(enumLevel(0)))
+	})
+}
+
+func TestGetFieldsEnum(t *testing.T) {
+	useEnum(t)
+	level := enumLevel(1)
+	fs := GetFields(enumReq{Status: 1, Verbose: 0, Color: 1, Count: 3, Level: 2, Unknown: 9, LevelPtr: &level})
+	if got, want := fs.Log(), "status[Active],verbose[Inactive self:0],color[green],count[3],level[info],unknown[9],level_ptr[debug]"; got != want {
+		t.Errorf("Log() = %q, want %q", got, want)
+	}
+	if got, want := fs.JSON(), `{"status":"Active","verbose":"Inactive","color":"green","count":"3","level":{"value":2,"transformed":"info"},"unknown":{"value":9,"transformed":9},"level_ptr":{"value":1,"transformed":"debug"}}`; got != want {
+		t.Errorf("JSON() = %q, want %q", got, want)
+	}
+}
+
+func TestGetFieldsEnumUnregistered(t *testing.T) {
+	type item struct {
+		Level enumLevel `log:"level,transform:enum"`
+	}
+	if got, want := GetFields(item{2}).Log(), "level[2]"; got != want {
+		t.Fatalf("Log() = %q, want %q", got, want)
+	}
+}
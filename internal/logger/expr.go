@@ -11,10 +11,37 @@
 
 package logger
 
-import "reflect"
+import (
+	"reflect"
+	"strings"
+)
 
 // expr defines an interface for evaluating expressions that compare original and new values.
 type expr interface {
 	// Expr generates a list of values based on a format string and the original and new reflect.Values.
 	Expr(format string, ov, sv reflect.Value) (values []any)
 }
+
+// jsonExpr defines an optional interface for expressions providing their own structured output.
+type jsonExpr interface {
+	// JSON generates a value suitable for JSON encoding based on the original and new reflect.Values.
+	JSON(ov, sv reflect.Value) (value any)
+}
+
+// maskExpr defines an interface for expressions that hide a field's value, such as masking, redaction and hashing.
+// Their output replaces the value in every renderer, and the raw value is never logged.
+type maskExpr interface {
+	expr
+	// mask generates the string that is logged in place of the value.
+	mask(sv reflect.Value) string
+}
+
+// maskExprValues generates the values of a masking expression for a format string.
+// Only formats with 2 placeholders (e.g., "%s[%v]") are supported, since the raw value must not be logged.
+func maskExprValues(m maskExpr, format string, sv reflect.Value) (values []any) {
+	if strings.Count(format, "%") != 2 {
+		// Invalid number of placeholders, return empty slice.
+		return
+	}
+	return []any{m.mask(sv)}
+}
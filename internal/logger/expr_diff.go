@@ -0,0 +1,37 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import "reflect"
+
+// Ensure exprDiff implements the expr interface.
+var _ expr = (*exprDiff)(nil)
+
+// exprDiff is a struct that holds the old and new values of a changed field.
+type exprDiff struct {
+	old, new any // old is the value before the change, new is the value after the change.
+}
+
+// newExprDiff creates a new exprDiff instance with the provided old and new values.
+func newExprDiff(old, new any) *exprDiff {
+	return &exprDiff{old, new}
+}
+
+// Expr returns the old and new values, to be rendered by a format such as "%s[%v=>%v]".
+func (d *exprDiff) Expr(_ string, _, _ reflect.Value) (values []any) {
+	return []any{d.old, d.new}
+}
+
+// JSON returns the old and new values for structured output.
+func (d *exprDiff) JSON(_, _ reflect.Value) (value any) {
+	return jsonObject{{"old", d.old}, {"new", d.new}}
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Ensure exprDuration implements the expr interface.
+var _ expr = (*exprDuration)(nil)
+
+// durationModes lists the modes accepted by the "duration:<mode>" tag option.
+var durationModes = map[string]bool{"human": true, "string": true, "ns": true, "ms": true, "s": true}
+
+// exprDuration is a struct that holds the rendering mode of time.Duration values.
+type exprDuration struct {
+	mode string // mode is one of durationModes (e.g., "human").
+}
+
+// newExprDuration creates a new exprDuration instance with the provided mode.
+func newExprDuration(mode string) *exprDuration {
+	return &exprDuration{mode}
+}
+
+// Expr renders a time.Duration value in the mode.
+// The format string determines the output structure:
+//   - For 2 placeholders (e.g., "%s[%v]"), returns [rendered duration].
+//   - For 3 placeholders (e.g., "%s[%v (%v)]"), returns [rendered duration, original value].
+func (d *exprDuration) Expr(format string, _, sv reflect.Value) (values []any) {
+	// Count placeholders in the format string to determine the output structure.
+	ftc := strings.Count(format, "%")
+	switch ftc {
+	default:
+		// Invalid number of placeholders, return empty slice.
+		return
+	case 2:
+		return []any{d.format(sv)}
+	case 3:
+		return []any{d.format(sv), sv.Interface()}
+	}
+}
+
+// JSON returns the rendered duration for structured output.
+func (d *exprDuration) JSON(_, sv reflect.Value) (value any) {
+	return d.format(sv)
+}
+
+// format renders the value if it is an integer, as a duration in nanoseconds:
+//   - "human" as whole days, hours, minutes and seconds (e.g., "1d 2h 30m"), or the string form below a second.
+//   - "ns", "ms" and "s" as a number in the unit.
+//   - Otherwise in the string form (e.g., "26h30m0s").
+func (d *exprDuration) format(sv reflect.Value) any {
+	if !sv.CanInt() {
+		return fmt.Sprintf("%v", sv.Interface())
+	}
+	du := time.Duration(sv.Int())
+	switch d.mode {
+	case "human":
+		return humanDuration(du)
+	case "ns":
+		return du.Nanoseconds()
+	case "ms":
+		return du.Milliseconds()
+	case "s":
+		return du.Seconds()
+	}
+	return du.String()
+}
+
+// humanDuration renders a duration as whole days, hours, minutes and seconds, omitting zero units.
+func humanDuration(du time.Duration) string {
+	sign := ""
+	if du < 0 {
+		sign, du = "-", -du
+	}
+	if du < time.Second {
+		return sign + du.String()
+	}
+	var partS []string
+	for _, unit := range []struct {
+		d    time.Duration
+		name string
+	}{{24 * time.Hour, "d"}, {time.Hour, "h"}, {time.Minute, "m"}, {time.Second, "s"}} {
+		if n := du / unit.d; n > 0 {
+			partS = append(partS, fmt.Sprintf("%d%s", n, unit.name))
+			du -= n * unit.d
+		}
+	}
+	return sign + strings.Join(partS, " ")
+}
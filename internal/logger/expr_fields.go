@@ -30,3 +30,11 @@ func newExprFields(fs FieldSlice) *exprFields {
 func (fs exprFields) Expr(_ string, _, _ reflect.Value) (values []any) {
 	return []any{fs.Log()}
 }
+
+// JSON returns the embedded FieldSlice as an array for array/slice values, or as an object otherwise.
+func (fs exprFields) JSON(_, sv reflect.Value) (value any) {
+	if isArray0(sv) {
+		return fs.jsonArray()
+	}
+	return fs.jsonObject()
+}
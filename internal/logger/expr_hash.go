@@ -0,0 +1,101 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"reflect"
+	"strings"
+)
+
+// Ensure exprHash implements the maskExpr interface.
+var _ maskExpr = (*exprHash)(nil)
+
+// hashFuncs maps the algorithms supported by the "hash:<algorithm>" tag option to their constructors.
+var hashFuncs = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// hashKey is the secret key of the keyed "hash:hmac-<algorithm>" tag option, guarded by settingsMu.
+var hashKey []byte
+
+// SetHashKey sets the secret key of the keyed "hash:hmac-<algorithm>" tag option.
+// Until a key is set, values of fields with a keyed hash are redacted entirely.
+func SetHashKey(key []byte) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	hashKey = append([]byte(nil), key...)
+}
+
+// getHashKey retrieves the secret key set by SetHashKey.
+func getHashKey() []byte {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return hashKey
+}
+
+// exprHash is a struct that holds a hashing expression, which replaces values with their hex digest.
+// Equal values keep equal digests, so they can still be correlated across log entries.
+// Plain digests of low-entropy values such as phone numbers can be reversed by brute force,
+// so they are not an anonymisation; a keyed digest (HMAC) cannot without the secret key.
+type exprHash struct {
+	expr0 string           // expr0 is the hash algorithm name (e.g., "sha256" or "hmac-sha256").
+	newH  func() hash.Hash // newH is the hash constructor, nil if the algorithm is not supported.
+	keyed bool             // keyed tells whether the digest is an HMAC with the key set by SetHashKey.
+}
+
+// newExprHash creates a new exprHash instance with the provided hash algorithm name,
+// which is keyed if prefixed with "hmac-".
+func newExprHash(expr0 string) *exprHash {
+	expr0 = strings.TrimSpace(expr0)
+	keyed := strings.HasPrefix(expr0, "hmac-")
+	return &exprHash{expr0, hashFuncs[strings.TrimPrefix(expr0, "hmac-")], keyed}
+}
+
+// Expr replaces a value with its hex digest.
+// The format string must have 2 placeholders (e.g., "%s[%v]"), returning [digest].
+func (h *exprHash) Expr(format string, _, sv reflect.Value) (values []any) {
+	return maskExprValues(h, format, sv)
+}
+
+// JSON returns the hex digest for structured output.
+func (h *exprHash) JSON(_, sv reflect.Value) (value any) {
+	return h.mask(sv)
+}
+
+// mask hashes the string form of the value, redacting it entirely if the algorithm is not supported,
+// or if the digest is keyed and no key is set.
+func (h *exprHash) mask(sv reflect.Value) string {
+	if h.newH == nil {
+		return Redacted
+	}
+	hh := h.newH()
+	if h.keyed {
+		key := getHashKey()
+		if len(key) == 0 {
+			return Redacted
+		}
+		hh = hmac.New(h.newH, key)
+	}
+	hh.Write([]byte(fmt.Sprintf("%v", sv.Interface())))
+	return hex.EncodeToString(hh.Sum(nil))
+}
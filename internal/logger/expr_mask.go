@@ -0,0 +1,77 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Ensure exprMask implements the maskExpr interface.
+var _ maskExpr = (*exprMask)(nil)
+
+// exprMask is a struct that holds a masking expression for partially hiding values.
+type exprMask struct {
+	expr0  string // expr0 is the masker name (e.g., "phone") or a keep expression (e.g., "keep(3,4)").
+	masker Masker // masker is the parsed keep masker, nil if expr0 names a registered masker.
+}
+
+// newExprMask creates a new exprMask instance with the provided masking expression,
+// parsing keep expressions once. Named maskers are looked up when the value is masked,
+// so that maskers registered after the first use of a type are honored.
+func newExprMask(expr0 string) *exprMask {
+	m := &exprMask{expr0: strings.TrimSpace(expr0)}
+	if head, tail, ok := parseKeep(m.expr0); ok {
+		m.masker = KeepMasker(head, tail)
+	}
+	return m
+}
+
+// parseKeep parses a keep expression (e.g., "keep(3,4)") into the number of leading and trailing characters to keep.
+func parseKeep(expr0 string) (head, tail int, ok bool) {
+	if !strings.HasPrefix(expr0, "keep(") || !strings.HasSuffix(expr0, ")") {
+		return
+	}
+	args := strings.Split(expr0[5:len(expr0)-1], ",")
+	if len(args) != 2 {
+		return
+	}
+	var err1, err2 error
+	head, err1 = strconv.Atoi(strings.TrimSpace(args[0]))
+	tail, err2 = strconv.Atoi(strings.TrimSpace(args[1]))
+	return head, tail, err1 == nil && err2 == nil && head >= 0 && tail >= 0
+}
+
+// Expr masks a value using a registered masker or a keep expression.
+// The format string must have 2 placeholders (e.g., "%s[%v]"), returning [masked value].
+func (m *exprMask) Expr(format string, _, sv reflect.Value) (values []any) {
+	return maskExprValues(m, format, sv)
+}
+
+// JSON returns the masked value for structured output.
+func (m *exprMask) JSON(_, sv reflect.Value) (value any) {
+	return m.mask(sv)
+}
+
+// mask applies the masker to the string form of the value, redacting it entirely if the masker is not registered.
+func (m *exprMask) mask(sv reflect.Value) string {
+	masker := m.masker
+	if masker == nil {
+		masker = getMasker(m.expr0)
+	}
+	if masker == nil {
+		return Redacted
+	}
+	return masker(fmt.Sprintf("%v", sv.Interface()))
+}
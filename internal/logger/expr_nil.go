@@ -0,0 +1,64 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Ensure exprNil implements the expr interface.
+var _ expr = (*exprNil)(nil)
+
+// exprNil is a struct for nil expressions, which log nil values according to the nil policy.
+type exprNil struct {
+	policy NilPolicy // policy is NilRender or NilEmpty.
+}
+
+// newExprNil creates a new exprNil instance with the provided nil policy.
+func newExprNil(policy NilPolicy) *exprNil {
+	return &exprNil{policy}
+}
+
+// Expr returns the text of a nil value, "<nil>" or empty depending on the policy.
+// The format string determines the output structure:
+//   - For 2 placeholders (e.g., "%s[%v]"), returns [text].
+//   - For 3 placeholders (e.g., "%s[%v=>%s]"), returns [text, text].
+func (n *exprNil) Expr(format string, _, _ reflect.Value) (values []any) {
+	// Count placeholders in the format string to determine the output structure.
+	ftc := strings.Count(format, "%")
+	switch ftc {
+	default:
+		// Invalid number of placeholders, return empty slice.
+		return
+	case 2:
+		return []any{n.text()}
+	case 3:
+		return []any{n.text(), n.text()}
+	}
+}
+
+// JSON returns null, or an empty string under the NilEmpty policy, for structured output.
+func (n *exprNil) JSON(_, _ reflect.Value) (value any) {
+	if n.policy == NilEmpty {
+		return ""
+	}
+	return
+}
+
+// text returns the text of a nil value under the policy.
+func (n *exprNil) text() string {
+	if n.policy == NilEmpty {
+		return ""
+	}
+	return "<nil>"
+}
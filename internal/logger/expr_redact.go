@@ -0,0 +1,41 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import "reflect"
+
+// Ensure exprRedact implements the maskExpr interface.
+var _ maskExpr = (*exprRedact)(nil)
+
+// exprRedact is a struct for redaction expressions, which hide values entirely.
+type exprRedact struct{}
+
+// newExprRedact creates a new exprRedact instance.
+func newExprRedact() *exprRedact {
+	return &exprRedact{}
+}
+
+// Expr replaces a value with the Redacted text.
+// The format string must have 2 placeholders (e.g., "%s[%v]"), returning [Redacted].
+func (r *exprRedact) Expr(format string, _, sv reflect.Value) (values []any) {
+	return maskExprValues(r, format, sv)
+}
+
+// JSON returns the Redacted text for structured output.
+func (r *exprRedact) JSON(_, sv reflect.Value) (value any) {
+	return r.mask(sv)
+}
+
+// mask returns the Redacted text regardless of the value.
+func (r *exprRedact) mask(_ reflect.Value) string {
+	return Redacted
+}
@@ -31,40 +31,11 @@ func newExprRef(expr0 string) *exprRef {
 
 // Expr generates a list of values based on a format string and reflect values, resolving a reference path.
 // The reference path (e.g., "Ref1.Ref2") navigates through nested struct fields.
+// A referenced field with a mask, redact or hash tag option, or matching the redaction policy, is masked.
 // The format string determines the output structure:
 //   - For 2 placeholders (e.g., "%s[%s]"), returns [reference value].
 //   - For 3 placeholders (e.g., "%s[%v=>%s]"), returns [reference value, original value].
 func (r *exprRef) Expr(format string, ov, sv reflect.Value) (values []any) {
-	expr0 := r.expr0
-
-	// refValFunc resolves the reference path to extract the target field value.
-	refValFunc := func() (a any) {
-		v := ov
-		if !strings.HasPrefix(expr0, ".") {
-			expr0 = "." + expr0
-		}
-		for {
-			dotIdx := strings.IndexByte(expr0, '.')
-			if dotIdx == -1 {
-				break
-			}
-			expr0 = expr0[dotIdx+1:]
-			dotIdx2 := strings.IndexByte(expr0, '.')
-			if dotIdx2 == -1 {
-				if expr0 != "" && v.Kind() == reflect.Struct {
-					fv := v.FieldByName(expr0)
-					if fv.IsValid() && fv.CanInterface() {
-						a = fv.Interface()
-					}
-				}
-				break
-			}
-			refName := expr0[:dotIdx2]
-			v = v.FieldByName(refName)
-		}
-		return
-	}
-
 	// Count placeholders in the format string to determine the output structure.
 	ftc := strings.Count(format, "%")
 	switch ftc {
@@ -74,10 +45,90 @@ func (r *exprRef) Expr(format string, ov, sv reflect.Value) (values []any) {
 	case 2:
 		// Format expects 2 placeholders (e.g., "%s[%s]").
 		// Returns the reference value.
-		return []any{refValFunc()}
+		return []any{r.ref(ov)}
 	case 3:
 		// Format expects 3 placeholders (e.g., "%s[%v=>%s]").
 		// Returns the reference value and the original value.
-		return []any{refValFunc(), sv.Interface()}
+		return []any{r.ref(ov), sv.Interface()}
+	}
+}
+
+// JSON returns the reference value for structured output, rendered like the referenced field itself:
+// with its tag options, the redaction policy, and the log tag rules for nested structs.
+func (r *exprRef) JSON(ov, _ reflect.Value) (value any) {
+	parent, name := r.resolve(ov)
+	fv := fieldByName(parent, name)
+	if !fv.IsValid() || !fv.CanInterface() {
+		return
+	}
+	fs, logged := r.fields(parent, name)
+	switch {
+	case !logged:
+		// Render fields that are not logged themselves as plain values, unless they match the redaction policy.
+		if shouldRedact(name) {
+			return Redacted
+		}
+		return jsonPlain(fv)
+	case len(fs) == 0:
+		// The referenced field is left out, e.g. by omitempty or the nil policy.
+		return
+	case len(fs) == 1:
+		return fs[0].jsonValue()
+	}
+	// Render the fields of an inline struct as an object.
+	return fs.jsonObject()
+}
+
+// ref resolves the reference path against the struct containing the field to extract the target field value,
+// masking it if the referenced field is masked when logged.
+func (r *exprRef) ref(ov reflect.Value) (a any) {
+	parent, name := r.resolve(ov)
+	fv := fieldByName(parent, name)
+	if !fv.IsValid() || !fv.CanInterface() {
+		return
+	}
+	if fs, logged := r.fields(parent, name); logged && len(fs) == 1 {
+		if m, ok := fs[0].expr.(maskExpr); ok {
+			return m.mask(fs[0].SV)
+		}
+	} else if !logged && shouldRedact(name) {
+		return Redacted
+	}
+	return fv.Interface()
+}
+
+// resolve navigates the reference path from the struct containing the field,
+// returning the struct holding the referenced field and the name of the referenced field.
+// The returned struct is invalid if the path does not resolve.
+func (r *exprRef) resolve(ov reflect.Value) (parent reflect.Value, name string) {
+	path := strings.Split(strings.TrimPrefix(r.expr0, "."), ".")
+	parent = ov
+	for _, refName := range path[:len(path)-1] {
+		parent = fieldByName(parent, refName)
+	}
+	return parent, path[len(path)-1]
+}
+
+// fields retrieves the fields logged for the named field of a struct by the compiled plan of the struct,
+// and whether the plan logs the field at all.
+func (r *exprRef) fields(parent reflect.Value, name string) (fs FieldSlice, logged bool) {
+	sf, ok := parent.Type().FieldByName(name)
+	if !ok || len(sf.Index) != 1 {
+		return
+	}
+	for _, fp := range getStructPlan(parent.Type(), false).fields {
+		if fp.index == sf.Index[0] {
+			return getPlanFields(parent, fp, false), true
+		}
+	}
+	return
+}
+
+// fieldByName retrieves the named field of a struct value, returning an invalid value if v is not a struct
+// or has no such field.
+func fieldByName(v reflect.Value, name string) reflect.Value {
+	if name == "" || v.Kind() != reflect.Struct {
+		return reflect.Value{}
 	}
+	return v.FieldByName(name)
 }
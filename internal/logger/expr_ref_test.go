@@ -0,0 +1,50 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import "testing"
+
+// refUser is a struct referenced by ref tag options in tests.
+type refUser struct {
+	Name    string   `log:"name"`
+	Phone   string   `log:"phone,mask:phone"`
+	Status  int      `log:"status,transform:1->active"`
+	Token   string   `log:"-"`
+	Address refPlace `log:"address"`
+}
+
+// refPlace is a nested struct referenced by ref tag options in tests.
+type refPlace struct {
+	City string `log:"city"`
+}
+
+// refOrder is a struct exercising the ref tag option in tests.
+type refOrder struct {
+	UserId  uint    `log:"user,ref:User.Name"`
+	Phone   string  `log:"phone,ref:User.Phone"`
+	Status  int     `log:"status,ref:User.Status"`
+	Token   string  `log:"token,ref:User.Token"`
+	Address string  `log:"address,ref:User.Address"`
+	User    refUser `log:"-"`
+}
+
+func TestExprRef(t *testing.T) {
+	useRedactionPolicy(t, "token")
+	order := refOrder{User: refUser{"Kellen", "13812345678", 1, "t0k3n", refPlace{"Paris"}}}
+	fs := GetFields(order)
+	if got, want := fs.Log(), "user[Kellen],phone[138****5678],status[1],token[[REDACTED]],address[{Paris}]"; got != want {
+		t.Errorf("Log() = %q, want %q", got, want)
+	}
+	if got, want := fs.JSON(), `{"user":"Kellen","phone":"138****5678","status":{"value":1,"transformed":"active"},"token":"[REDACTED]","address":{"city":"Paris"}}`; got != want {
+		t.Errorf("JSON() = %q, want %q", got, want)
+	}
+}
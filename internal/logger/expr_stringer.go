@@ -0,0 +1,70 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Ensure exprStringer implements the expr interface.
+var _ expr = (*exprStringer)(nil)
+
+// exprStringer is a struct for stringer expressions, which log values by their String or MarshalText method.
+type exprStringer struct{}
+
+// newExprStringer creates a new exprStringer instance.
+func newExprStringer() *exprStringer {
+	return &exprStringer{}
+}
+
+// Expr converts a value to its string form using fmt.Stringer or encoding.TextMarshaler.
+// The format string determines the output structure:
+//   - For 2 placeholders (e.g., "%s[%s]"), returns [string value].
+//   - For 3 placeholders (e.g., "%s[%s self:%v]"), returns [string value, original value].
+func (s *exprStringer) Expr(format string, _, sv reflect.Value) (values []any) {
+	// Count placeholders in the format string to determine the output structure.
+	ftc := strings.Count(format, "%")
+	switch ftc {
+	default:
+		// Invalid number of placeholders, return empty slice.
+		return
+	case 2:
+		return []any{s.string(sv)}
+	case 3:
+		return []any{s.string(sv), sv.Interface()}
+	}
+}
+
+// JSON returns the string value for structured output.
+func (s *exprStringer) JSON(_, sv reflect.Value) (value any) {
+	return s.string(sv)
+}
+
+// string converts a value to its string form, preferring fmt.Stringer over encoding.TextMarshaler,
+// and honoring methods with pointer receivers. Values implementing neither are formatted with "%v".
+func (s *exprStringer) string(sv reflect.Value) string {
+	// Use a pointer to the value, so that methods with pointer receivers are found as well.
+	pv := reflect.New(sv.Type())
+	pv.Elem().Set(sv)
+	switch v := pv.Interface().(type) {
+	case fmt.Stringer:
+		return v.String()
+	case encoding.TextMarshaler:
+		if text, err := v.MarshalText(); err == nil {
+			return string(text)
+		}
+	}
+	return fmt.Sprintf("%v", sv.Interface())
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Ensure exprTime implements the expr interface.
+var _ expr = (*exprTime)(nil)
+
+// timeLayouts maps the layout names accepted by the "time:<layout>" tag option to their layouts.
+// Named layouts allow layouts with commas, which would otherwise split the tag.
+var timeLayouts = map[string]string{
+	"RFC3339":     time.RFC3339,
+	"RFC3339Nano": time.RFC3339Nano,
+	"RFC1123":     time.RFC1123,
+	"RFC1123Z":    time.RFC1123Z,
+	"RFC822":      time.RFC822,
+	"Kitchen":     time.Kitchen,
+	"DateTime":    "2006-01-02 15:04:05",
+	"DateOnly":    "2006-01-02",
+	"TimeOnly":    "15:04:05",
+}
+
+// exprTime is a struct that holds the layout and time zone for rendering time.Time values.
+type exprTime struct {
+	layout string         // layout is the time layout, or "unix"/"unixmilli" for timestamps.
+	loc    *time.Location // loc is the time zone to convert to, nil to keep the value's own.
+}
+
+// newExprTime creates a new exprTime instance with the default layout and the value's own time zone.
+func newExprTime() *exprTime {
+	return &exprTime{layout: defaultTimeLayout}
+}
+
+// setLayout sets the layout from a "time:<layout>" tag option, resolving named layouts.
+func (t *exprTime) setLayout(layout string) {
+	if named, ok := timeLayouts[layout]; ok {
+		layout = named
+	}
+	t.layout = layout
+}
+
+// setZone sets the time zone from a "tz:<zone>" tag option (e.g., "Asia/Shanghai", "UTC" or "Local").
+// Unknown zones keep the value's own time zone.
+func (t *exprTime) setZone(zone string) {
+	t.loc, _ = time.LoadLocation(zone)
+}
+
+// Expr formats a time.Time value with the layout and time zone.
+// The format string determines the output structure:
+//   - For 2 placeholders (e.g., "%s[%v]"), returns [formatted time].
+//   - For 3 placeholders (e.g., "%s[%v (%v)]"), returns [formatted time, original value].
+func (t *exprTime) Expr(format string, _, sv reflect.Value) (values []any) {
+	// Count placeholders in the format string to determine the output structure.
+	ftc := strings.Count(format, "%")
+	switch ftc {
+	default:
+		// Invalid number of placeholders, return empty slice.
+		return
+	case 2:
+		return []any{t.format(sv)}
+	case 3:
+		return []any{t.format(sv), sv.Interface()}
+	}
+}
+
+// JSON returns the formatted time for structured output.
+func (t *exprTime) JSON(_, sv reflect.Value) (value any) {
+	return t.format(sv)
+}
+
+// format formats the value if it is a time.Time, or else formats it with "%v".
+func (t *exprTime) format(sv reflect.Value) any {
+	tm, ok := sv.Interface().(time.Time)
+	if !ok {
+		return fmt.Sprintf("%v", sv.Interface())
+	}
+	if t.loc != nil {
+		tm = tm.In(t.loc)
+	}
+	switch t.layout {
+	case "unix":
+		return tm.Unix()
+	case "unixmilli":
+		return tm.UnixMilli()
+	}
+	return tm.Format(t.layout)
+}
@@ -13,7 +13,9 @@ package logger
 
 import (
 	"fmt"
+	"math"
 	"reflect"
+	"strconv"
 	"strings"
 )
 
@@ -22,12 +24,101 @@ var _ expr = (*exprTransform)(nil)
 
 // exprTransform is a struct that holds a transformation expression for mapping values.
 type exprTransform struct {
-	expr0 string // expr0 is the transformation mapping (e.g., "1->on|2->off").
+	expr0  string           // expr0 is the transformation mapping (e.g., "1->on|2->off") or function reference (e.g., "@status").
+	m      map[string]any   // m is the parsed transformation mapping, keyed by the original value.
+	ranges []transformRange // ranges are the parsed numeric range mappings, in order.
+	def    any              // def is the value of the default branch, if hasDef is true.
+	hasDef bool             // hasDef tells whether the mapping has a default branch ("*" or "_").
+	fn     string           // fn is the name of the registered transform function, empty for a mapping.
+	enum   bool             // enum tells whether the value is looked up in the enum table registered for its type.
 }
 
-// newExprTransform creates a new exprTransform instance with the provided transformation expression.
+// transformRange is a numeric range mapping (e.g., "1..5->low"), inclusive at both ends.
+type transformRange struct {
+	lo, hi float64 // lo and hi are the range bounds, infinite if omitted (e.g., "10..").
+	value  any     // value is the transformed value.
+}
+
+// newExprTransform creates a new exprTransform instance with the provided transformation expression,
+// parsing the mapping once so that it can be shared by compiled plans.
+// An expression starting with "@" references a function registered with RegisterTransform,
+// which is looked up when the value is transformed, and "enum" references the enum table registered
+// with RegisterEnum for the type of the value.
 func newExprTransform(expr0 string) *exprTransform {
-	return &exprTransform{expr0}
+	if name := strings.TrimSpace(expr0); strings.HasPrefix(name, "@") {
+		return &exprTransform{expr0: expr0, fn: name[1:]}
+	} else if name == "enum" {
+		return &exprTransform{expr0: expr0, enum: true}
+	}
+	t, _ := parseTransform(expr0)
+	return t
+}
+
+// parseTransform parses a transformation mapping of "|"-separated branches, each mapping keys to a value:
+//   - Exact keys, possibly several per branch (e.g., "1->on" or "1,2,3->active").
+//   - Numeric ranges, inclusive and possibly open-ended (e.g., "1..5->low" or "10..->high").
+//   - A default branch for any other value (e.g., "*->other" or "_->unknown").
+//
+// Values matching no branch are logged as is when there is no default branch, see transform.
+// Malformed branches are skipped and described in msgS.
+func parseTransform(expr0 string) (t *exprTransform, msgS []string) {
+	t = &exprTransform{expr0: expr0, m: map[string]any{}}
+	for _, val := range strings.Split(expr0, "|") {
+		// Split each branch into keys and value (e.g., "1,2->on" into "1,2" and "on").
+		vv := strings.Split(val, "->")
+		if len(vv) != 2 || strings.TrimSpace(vv[0]) == "" {
+			msgS = append(msgS, fmt.Sprintf("transform %q: mapping %q is not key->value", expr0, val))
+			continue
+		}
+		v := strings.TrimSpace(vv[1])
+		for _, k := range strings.Split(vv[0], ",") {
+			k = strings.TrimSpace(k)
+			switch {
+			case k == "*" || k == "_":
+				// Handle the default branch.
+				if t.hasDef {
+					msgS = append(msgS, fmt.Sprintf("transform %q: duplicate default branch", expr0))
+				}
+				t.def, t.hasDef = v, true
+			case strings.Contains(k, ".."):
+				// Handle a numeric range.
+				if r, ok := parseTransformRange(k, v); ok {
+					t.ranges = append(t.ranges, r)
+				} else {
+					msgS = append(msgS, fmt.Sprintf("transform %q: invalid range %q", expr0, k))
+				}
+			default:
+				// Handle an exact key.
+				if _, ok := t.m[k]; ok {
+					msgS = append(msgS, fmt.Sprintf("transform %q: duplicate key %q", expr0, k))
+				}
+				t.m[k] = v
+			}
+		}
+	}
+	return
+}
+
+// parseTransformRange parses a numeric range key (e.g., "1..5", "..0" or "10..") with its value.
+func parseTransformRange(k string, v any) (r transformRange, ok bool) {
+	bounds := strings.SplitN(k, "..", 2)
+	lo, hi := strings.TrimSpace(bounds[0]), strings.TrimSpace(bounds[1])
+	if lo == "" && hi == "" {
+		return
+	}
+	r = transformRange{lo: math.Inf(-1), hi: math.Inf(1), value: v}
+	var err error
+	if lo != "" {
+		if r.lo, err = strconv.ParseFloat(lo, 64); err != nil {
+			return
+		}
+	}
+	if hi != "" {
+		if r.hi, err = strconv.ParseFloat(hi, 64); err != nil {
+			return
+		}
+	}
+	return r, r.lo <= r.hi
 }
 
 // Expr transforms a value based on a mapping defined in expr0 (e.g., "1->on|2->off").
@@ -36,24 +127,6 @@ func newExprTransform(expr0 string) *exprTransform {
 //   - For 2 placeholders (e.g., "%s[%s]"), returns [transformed value].
 //   - For 3 placeholders (e.g., "%s[%v=>%s]"), returns [transformed value, original value].
 func (t *exprTransform) Expr(format string, _, sv reflect.Value) (values []any) {
-	// Split the transformation expression into individual mappings.
-	valS := strings.Split(t.expr0, "|")
-	var m = map[string]any{}
-	for _, val := range valS {
-		// Split each mapping into key and value (e.g., "1->on" into "1" and "on").
-		vv := strings.Split(val, "->")
-		if len(vv) > 1 {
-			k := strings.TrimSpace(vv[0])
-			v := strings.TrimSpace(vv[1])
-			m[k] = v
-		}
-	}
-
-	// Get the original value and convert it to a string key.
-	svv := sv.Interface()
-	k := fmt.Sprintf("%v", svv)
-	sa := m[k]
-
 	// Count placeholders in the format string to determine the output structure.
 	ftc := strings.Count(format, "%")
 	switch ftc {
@@ -63,10 +136,73 @@ func (t *exprTransform) Expr(format string, _, sv reflect.Value) (values []any)
 	case 2:
 		// Format expects 2 placeholders (e.g., "%s[%s]").
 		// Returns the transformed value.
-		return []any{sa}
+		return []any{t.transform(sv)}
 	case 3:
 		// Format expects 3 placeholders (e.g., "%s[%v=>%s]").
 		// Returns the transformed value and the original value.
-		return []any{sa, svv}
+		return []any{t.transform(sv), sv.Interface()}
+	}
+}
+
+// JSON returns the original value alongside the transformed value for structured output.
+func (t *exprTransform) JSON(_, sv reflect.Value) (value any) {
+	return jsonObject{{"value", sv.Interface()}, {"transformed", t.transform(sv)}}
+}
+
+// transform maps the original value to its transformed value.
+// If no branch matches and there is no default branch, or the referenced function or enum table
+// is not registered, the original value is returned unchanged. This replaces the nil returned for
+// unmatched values before default branches were supported, which rendered as "<nil>" or "%!s(<nil>)";
+// a default branch such as "*->" or "*->unknown" keeps unmatched values out of the log.
+func (t *exprTransform) transform(sv reflect.Value) any {
+	raw := sv.Interface()
+
+	// Look up the value in the enum table registered for its type.
+	if t.enum {
+		if label, ok := getEnum(sv.Type())[raw]; ok {
+			return label
+		}
+		return raw
+	}
+
+	// Apply the registered transform function, if referenced.
+	if t.fn != "" {
+		if fn := getTransform(t.fn); fn != nil {
+			return fn(raw)
+		}
+		return raw
+	}
+
+	// Convert the original value to a string key and look up its mapping.
+	if v, ok := t.m[fmt.Sprintf("%v", raw)]; ok {
+		return v
+	}
+
+	// Look up the numeric value in the ranges, in order.
+	if f, ok := toFloat(sv); ok {
+		for _, r := range t.ranges {
+			if f >= r.lo && f <= r.hi {
+				return r.value
+			}
+		}
+	}
+
+	// Fall back to the default branch, or else the original value.
+	if t.hasDef {
+		return t.def
+	}
+	return raw
+}
+
+// toFloat converts a numeric value to float64 for range comparisons.
+func toFloat(sv reflect.Value) (f float64, ok bool) {
+	switch {
+	case sv.CanInt():
+		return float64(sv.Int()), true
+	case sv.CanUint():
+		return float64(sv.Uint()), true
+	case sv.CanFloat():
+		return sv.Float(), true
 	}
+	return
 }
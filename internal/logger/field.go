@@ -11,46 +11,31 @@
 
 package logger
 
-import (
-	"fmt"
-	"reflect"
-)
+import "reflect"
 
 // Field represents a log field with a name, format, expression, and original/new values.
 type Field struct {
-	Name, Format string        // Name is the field name, Format is the log format string.
+	Name, Format string        // Name is the field name, Format is the log format string (empty for the renderer's default).
 	expr                       // expr is the embedded expression interface for evaluating values.
 	SV, OV       reflect.Value // SV is the new value, OV is the original value.
 }
 
-// log generates a formatted log string based on the field's format and evaluated values.
-func (f Field) log() (str string) {
-	var values []any
-	if f.Name != "" {
-		values = append(values, f.Name)
-	}
-	values = append(values, f.eval()...)
-	return fmt.Sprintf(f.Format, values...)
+// value retrieves the field's value based on its type, handling basic types, arrays/slices, and maps.
+func (f Field) value() (v any) {
+	return BracketRenderer{}.resolve().value(f.SV)
 }
 
-// eval evaluates the field's expression or value to produce a list of log values.
-func (f Field) eval() (values []any) {
-	if f.expr != nil {
-		return f.Expr(f.Format, f.OV, f.SV)
+// diffValue evaluates the field to the single value that is compared and displayed by GetDiff.
+// A field whose value is a nil pointer evaluates to nil.
+func (f Field) diffValue() (v any) {
+	if !f.SV.IsValid() {
+		return
 	}
-	return []any{f.value()}
-}
-
-// value retrieves the field's value based on its type, handling basic types, arrays/slices, and maps.
-func (f Field) value() (v any) {
-	vk := f.SV.Kind()
-	switch {
-	case vk >= reflect.Bool && vk <= reflect.Float64 || vk == reflect.String || vk == reflect.Struct:
-		return f.SV.Interface()
-	case vk == reflect.Array || vk == reflect.Slice:
-		return arrayFunc0(f.SV)
-	case vk == reflect.Map:
-		return mapFunc0(f.SV)
+	if f.expr != nil {
+		if values := f.Expr("%s[%v]", f.OV, f.SV); len(values) > 0 {
+			return values[0]
+		}
+		return
 	}
-	return
+	return f.value()
 }
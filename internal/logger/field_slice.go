@@ -11,18 +11,15 @@
 
 package logger
 
-import "strings"
-
 // FieldSlice is a slice of Field structs for logging multiple fields.
 type FieldSlice []Field
 
 // Log generates a concatenated string of log entries from all fields in the slice, joined by a separator.
 func (fs FieldSlice) Log() string {
-	var strS []string
-	for _, f := range fs {
-		if fStr := f.log(); fStr != "" {
-			strS = append(strS, fStr)
-		}
-	}
-	return strings.Join(strS, fieldJoinSep)
+	return fs.Render(BracketRenderer{})
+}
+
+// Render generates a log string from all fields in the slice using the given Renderer.
+func (fs FieldSlice) Render(r Renderer) string {
+	return r.Render(fs)
 }
@@ -0,0 +1,99 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import "reflect"
+
+// TypeFormatter defines a function type for converting a value of a registered type to the value that is logged.
+type TypeFormatter func(v reflect.Value) any
+
+// LogValuer is implemented by types that provide the value that is logged in place of themselves.
+type LogValuer interface {
+	// LogValue returns the value that is logged.
+	LogValue() any
+}
+
+// logValuerType is the reflect.Type of the LogValuer interface.
+var logValuerType = reflect.TypeOf((*LogValuer)(nil)).Elem()
+
+// formatters is the registry of type formatters by type, guarded by settingsMu.
+var formatters = map[reflect.Type]TypeFormatter{}
+
+// RegisterTypeFormatter registers a formatter for a type, used for fields of the type or a pointer to it,
+// and for the elements and values of arrays/slices and maps, before any other rendering.
+// Fields of the type are logged as single values, even if it is a struct or of an otherwise unsupported kind,
+// such as decimal.Decimal, UUIDs, sql.NullString or json.RawMessage.
+// Registering a type again replaces its previous formatter, and a nil formatter removes it.
+func RegisterTypeFormatter(typ reflect.Type, formatter TypeFormatter) {
+	settingsMu.Lock()
+	if formatter == nil {
+		delete(formatters, typ)
+	} else {
+		formatters[typ] = formatter
+	}
+	settingsMu.Unlock()
+
+	// Clear the compiled plans, which depend on whether a type is logged as a single value.
+	resetPlans()
+}
+
+// RegisterFormatter registers a formatter for the type T, like RegisterTypeFormatter.
+func RegisterFormatter[T any](formatter func(v T) any) {
+	RegisterTypeFormatter(reflect.TypeOf((*T)(nil)).Elem(), func(v reflect.Value) any {
+		return formatter(v.Interface().(T))
+	})
+}
+
+// getFormatter retrieves the registered formatter of a type, returning nil if none is registered.
+func getFormatter(typ reflect.Type) TypeFormatter {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return formatters[typ]
+}
+
+// isScalar reports whether a type is logged as a single value regardless of its kind:
+// types with a registered formatter, types implementing LogValuer, and built-in scalar types.
+func isScalar(t reflect.Type) bool {
+	return getFormatter(t) != nil || t.Implements(logValuerType) || reflect.PointerTo(t).Implements(logValuerType) || isBuiltinScalar(t)
+}
+
+// scalarValue converts a value of a scalar type to the value that is logged, consulting in order
+// the registered formatters, the LogValuer interface, and the built-in types.
+// The ok result is false for other types.
+func scalarValue(sv reflect.Value) (v any, ok bool) {
+	if formatter := getFormatter(sv.Type()); formatter != nil {
+		return formatter(sv), true
+	}
+	if lv, ok := logValuer(sv); ok {
+		return lv.LogValue(), true
+	}
+	return builtinValue(sv)
+}
+
+// logValuer retrieves the LogValuer implementation of a value, honoring methods with pointer receivers.
+func logValuer(sv reflect.Value) (lv LogValuer, ok bool) {
+	switch {
+	case sv.Kind() == reflect.Interface && sv.IsNil():
+		return
+	case sv.Type().Implements(logValuerType):
+		if sv.Kind() == reflect.Pointer && sv.IsNil() {
+			return
+		}
+		return sv.Interface().(LogValuer), true
+	case reflect.PointerTo(sv.Type()).Implements(logValuerType):
+		// Use a pointer to a copy of the value, which may not be addressable.
+		pv := reflect.New(sv.Type())
+		pv.Elem().Set(sv)
+		return pv.Interface().(LogValuer), true
+	}
+	return
+}
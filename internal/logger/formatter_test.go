@@ -0,0 +1,98 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+)
+
+// formatterMoney is a struct type implementing LogValuer with a pointer receiver in tests.
+type formatterMoney struct {
+	Cents int
+}
+
+// LogValue returns the amount in dollars.
+func (m *formatterMoney) LogValue() any {
+	return float64(m.Cents) / 100
+}
+
+// formatterPoint is a struct type with a registered formatter and a LogValue method in tests.
+type formatterPoint struct {
+	X, Y int
+}
+
+// LogValue returns a value that the registered formatter takes precedence over.
+func (p formatterPoint) LogValue() any {
+	return "log-value"
+}
+
+// formatterOrder is a struct exercising type formatters and LogValuer in tests.
+type formatterOrder struct {
+	Note   sql.NullString            `log:"note"`
+	Price  formatterMoney            `log:"price"`
+	Prices []formatterMoney          `log:"prices"`
+	Origin formatterPoint            `log:"origin"`
+	ByName map[string]formatterPoint `log:"by_name"`
+}
+
+// useFormatter registers formatter for typ for the duration of the test.
+func useFormatter(t *testing.T, typ reflect.Type, formatter TypeFormatter) {
+	RegisterTypeFormatter(typ, formatter)
+	t.Cleanup(func() { RegisterTypeFormatter(typ, nil) })
+}
+
+func TestRegisterTypeFormatter(t *testing.T) {
+	RegisterFormatter(func(v sql.NullString) any {
+		if !v.Valid {
+			return "null"
+		}
+		return v.String
+	})
+	t.Cleanup(func() { RegisterTypeFormatter(reflect.TypeOf(sql.NullString{}), nil) })
+	useFormatter(t, reflect.TypeOf(formatterPoint{}), func(v reflect.Value) any {
+		p := v.Interface().(formatterPoint)
+		return p.X*10 + p.Y
+	})
+
+	order := formatterOrder{
+		Price:  formatterMoney{1234},
+		Prices: []formatterMoney{{100}, {250}},
+		Origin: formatterPoint{1, 2},
+		ByName: map[string]formatterPoint{"a": {3, 4}},
+	}
+	fs := GetFields(order)
+	if got, want := fs.Log(), "note[null],price[12.34],prices[1,2.5],origin[12],by_name[a:34]"; got != want {
+		t.Errorf("Log() = %q, want %q", got, want)
+	}
+	if got, want := fs.JSON(), `{"note":"null","price":12.34,"prices":[1,2.5],"origin":12,"by_name":{"a":34}}`; got != want {
+		t.Errorf("JSON() = %q, want %q", got, want)
+	}
+
+	order.Note = sql.NullString{String: "fragile", Valid: true}
+	if got, want := GetFields(order).Log(), "note[fragile],price[12.34],prices[1,2.5],origin[12],by_name[a:34]"; got != want {
+		t.Errorf("Log() = %q, want %q", got, want)
+	}
+}
+
+func TestRegisterTypeFormatterRemoved(t *testing.T) {
+	typ := reflect.TypeOf(formatterPoint{})
+	RegisterTypeFormatter(typ, func(v reflect.Value) any { return "formatted" })
+	RegisterTypeFormatter(typ, nil)
+	type item struct {
+		Origin formatterPoint `log:"origin"`
+	}
+	if got, want := GetFields(item{}).Log(), "origin[log-value]"; got != want {
+		t.Fatalf("Log() = %q, want %q", got, want)
+	}
+}
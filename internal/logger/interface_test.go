@@ -0,0 +1,75 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"errors"
+	"testing"
+)
+
+// ifaceInner is a struct logged through an interface field in tests.
+type ifaceInner struct {
+	A int `log:"a"`
+}
+
+// ifaceReq is a struct exercising interface-typed fields in tests.
+type ifaceReq struct {
+	Extra any `log:"extra"`
+}
+
+func TestGetFieldsInterface(t *testing.T) {
+	var nilInner *ifaceInner
+	tests := []struct {
+		name  string
+		extra any
+		log   string
+		json  string
+	}{
+		{"nil", nil, "extra[<nil>]", `{"extra":null}`},
+		{"nil pointer", nilInner, "extra[<nil>]", `{"extra":null}`},
+		{"struct", ifaceInner{1}, "extra[a[1]]", `{"extra":{"a":1}}`},
+		{"struct pointer", &ifaceInner{2}, "extra[a[2]]", `{"extra":{"a":2}}`},
+		{"slice of structs", []ifaceInner{{1}, {2}}, "extra[{a[1]},{a[2]}]", `{"extra":[{"a":1},{"a":2}]}`},
+		{"basic", 42, "extra[42]", `{"extra":42}`},
+		{"error", errors.New("boom"), "extra[boom]", `{"extra":"boom"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := GetFields(ifaceReq{tt.extra})
+			if got := fs.Log(); got != tt.log {
+				t.Errorf("Log() = %q, want %q", got, tt.log)
+			}
+			if got := fs.JSON(); got != tt.json {
+				t.Errorf("JSON() = %q, want %q", got, tt.json)
+			}
+		})
+	}
+}
+
+func TestGetDiffInterface(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new any
+		want     string
+	}{
+		{"unchanged", ifaceInner{1}, ifaceInner{1}, ""},
+		{"nested", ifaceInner{1}, ifaceInner{2}, "extra[a[1=>2]]"},
+		{"basic", 1, 2, "extra[1=>2]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetDiff(ifaceReq{tt.old}, ifaceReq{tt.new}).Log(); got != tt.want {
+				t.Fatalf("GetDiff().Log() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// jsonMember is a single name-value pair of a jsonObject.
+type jsonMember struct {
+	name  string
+	value any
+}
+
+// jsonObject is a JSON object that keeps its members in order.
+type jsonObject []jsonMember
+
+// MarshalJSON encodes the object members in order.
+func (o jsonObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, m := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(m.name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// JSON generates a JSON object from all fields in the slice, keyed by field name.
+// Nested structs are rendered as objects, arrays/slices as arrays, maps as objects,
+// and transformed values alongside their original values. Returns an empty string if encoding fails.
+func (fs FieldSlice) JSON() string {
+	bs, err := json.Marshal(fs.jsonObject())
+	if err != nil {
+		return ""
+	}
+	return string(bs)
+}
+
+// jsonObject converts the fields into an ordered object keyed by field name.
+func (fs FieldSlice) jsonObject() (o jsonObject) {
+	o = jsonObject{}
+	for _, f := range fs {
+		o = append(o, jsonMember{f.Name, f.jsonValue()})
+	}
+	return
+}
+
+// jsonArray converts the fields, such as the elements of an array/slice, into an array of values.
+func (fs FieldSlice) jsonArray() (a []any) {
+	a = make([]any, 0, len(fs))
+	for _, f := range fs {
+		a = append(a, f.jsonValue())
+	}
+	return
+}
+
+// jsonValue evaluates the field's expression or value to produce a value suitable for JSON encoding.
+func (f Field) jsonValue() (value any) {
+	// Expressions providing their own structured output come first, since nil and diff fields may have no value.
+	if je, ok := f.expr.(jsonExpr); ok {
+		return je.JSON(f.OV, f.SV)
+	}
+	switch {
+	case !f.SV.IsValid():
+		return
+	case f.expr == nil:
+		return jsonPlain(f.SV)
+	}
+	if values := f.Expr("%s[%v]", f.OV, f.SV); len(values) > 0 {
+		return values[0]
+	}
+	return
+}
+
+// jsonPlain converts a value without expression to a value suitable for JSON encoding,
+// rendering scalar types as in the bracket style, structs and arrays/slices of structs with the log tag rules,
+// and recursing into arrays/slices and maps. Map keys are sorted by the map key comparator for a stable output,
+// and the values of keys matching the redaction policy are redacted.
+func jsonPlain(v reflect.Value) (value any) {
+	v = rv(dynamicValue(v))
+	if !v.IsValid() || !v.CanInterface() {
+		return
+	}
+	if value, ok := scalarValue(v); ok {
+		return value
+	}
+	switch {
+	case isNested(v.Type()):
+		// Apply the log tag rules to structs and arrays/slices of structs.
+		fs := getSupportedFields(v)
+		if isArray0(v) {
+			return fs.jsonArray()
+		}
+		return fs.jsonObject()
+	case isArray0(v):
+		a := make([]any, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			a = append(a, jsonPlain(v.Index(i)))
+		}
+		return a
+	case v.Kind() == reflect.Map:
+		o := jsonObject{}
+		seen := map[string]bool{}
+		for _, e := range sortedMapEntries(v) {
+			// Redact values whose key matches the redaction policy.
+			name := fmt.Sprintf("%v", e.key)
+			if shouldRedact(name) {
+				o = append(o, jsonMember{uniqueName(name, seen), Redacted})
+			} else {
+				o = append(o, jsonMember{uniqueName(name, seen), jsonPlain(e.value)})
+			}
+		}
+		return o
+	}
+	return v.Interface()
+}
+
+// uniqueName returns name, or name suffixed with "#2", "#3", etc. if it is already in seen, and adds the result to seen.
+// Distinct map keys can share a string form, such as NaN keys, or 1 and "1" in a map[any], while JSON object
+// member names must be unique.
+func uniqueName(name string, seen map[string]bool) string {
+	unique := name
+	for i := 2; seen[unique]; i++ {
+		unique = fmt.Sprintf("%s#%d", name, i)
+	}
+	seen[unique] = true
+	return unique
+}
@@ -25,6 +25,12 @@ type LogInfo interface {
 	LogFields() (fields FieldSlice)
 }
 
+// LogRenderer defines an optional method for choosing how the log fields are rendered.
+type LogRenderer interface {
+	// LogRenderer returns the Renderer used for the log fields.
+	LogRenderer() (renderer Renderer)
+}
+
 // LogUserClientIP combines LogUser and LogClientIP interfaces for user and client IP logging.
 type LogUserClientIP interface {
 	LogUser
@@ -0,0 +1,66 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import "strings"
+
+// Redacted is the text logged in place of a redacted value, or a value whose masker is not registered.
+const Redacted = "[REDACTED]"
+
+// Masker defines a function type for masking the string form of a field value.
+type Masker func(s string) string
+
+// maskers is the registry of maskers by name, referenced by the "mask:<name>" tag option and guarded by settingsMu.
+var maskers = map[string]Masker{
+	"phone":  KeepMasker(3, 4),
+	"idcard": KeepMasker(4, 4),
+	"email":  maskEmail,
+}
+
+// RegisterMasker registers a custom masker under a name, to be referenced by the "mask:<name>" tag option.
+// Registering a name again replaces the previous masker, including the built-in "phone", "email" and "idcard".
+func RegisterMasker(name string, masker Masker) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	maskers[name] = masker
+}
+
+// getMasker retrieves a registered masker by name, returning nil if none is registered.
+func getMasker(name string) Masker {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return maskers[name]
+}
+
+// KeepMasker creates a Masker that keeps the first head and last tail characters and masks the rest with "*"
+// (e.g., KeepMasker(3, 4) masks "13812345678" as "138****5678").
+// Values not longer than head+tail are masked entirely.
+func KeepMasker(head, tail int) Masker {
+	return func(s string) string {
+		rs := []rune(s)
+		if len(rs) <= head+tail {
+			return strings.Repeat("*", len(rs))
+		}
+		return string(rs[:head]) + strings.Repeat("*", len(rs)-head-tail) + string(rs[len(rs)-tail:])
+	}
+}
+
+// maskEmail masks the local part of an email address except its first character
+// (e.g., "kellen@example.com" as "k***@example.com"). Values without "@" are masked entirely.
+func maskEmail(s string) string {
+	at := strings.LastIndexByte(s, '@')
+	if at <= 0 {
+		return KeepMasker(0, 0)(s)
+	}
+	rs := []rune(s[:at])
+	return string(rs[0]) + "***" + s[at:]
+}
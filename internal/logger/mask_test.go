@@ -0,0 +1,111 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+)
+
+// maskUser is a struct exercising the mask, redact and hash tag options in tests.
+type maskUser struct {
+	Name     string `log:"name,mask:initial"`
+	Phone    string `log:"phone,mask:phone"`
+	Email    string `log:"email,mask:email"`
+	IDCard   string `log:"id,mask:keep(3,4)"`
+	Password string `log:"password,redact"`
+	Token    string `log:"token,hash:sha256"`
+	Secret   string `log:"secret,mask:unknown"`
+}
+
+// useMasker registers masker under name for the duration of the test.
+func useMasker(t *testing.T, name string, masker Masker) {
+	RegisterMasker(name, masker)
+	t.Cleanup(func() {
+		settingsMu.Lock()
+		defer settingsMu.Unlock()
+		delete(maskers, name)
+	})
+}
+
+func TestKeepMasker(t *testing.T) {
+	tests := []struct {
+		head, tail int
+		in, want   string
+	}{
+		{3, 4, "13812345678", "138****5678"},
+		{1, 0, "Kellen", "K*****"},
+		{2, 2, "abc", "***"},
+		{1, 1, "张三丰", "张*丰"},
+	}
+	for _, tt := range tests {
+		if got := KeepMasker(tt.head, tt.tail)(tt.in); got != tt.want {
+			t.Errorf("KeepMasker(%d, %d)(%q) = %q, want %q", tt.head, tt.tail, tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestGetFieldsSensitive(t *testing.T) {
+	useMasker(t, "initial", KeepMasker(1, 0))
+	sum := sha256.Sum256([]byte("t0k3n"))
+	token := hex.EncodeToString(sum[:])
+	user := maskUser{"Kellen", "13812345678", "kellen@example.com", "110101199001011234", "p@ss", "t0k3n", "s3cr3t"}
+	fs := GetFields(user)
+	if got, want := fs.Log(), "name[K*****],phone[138****5678],email[k***@example.com],id[110***********1234],password[[REDACTED]],token["+token+"],secret[[REDACTED]]"; got != want {
+		t.Errorf("Log() = %q, want %q", got, want)
+	}
+	if got, want := fs.JSON(), `{"name":"K*****","phone":"138****5678","email":"k***@example.com","id":"110***********1234","password":"[REDACTED]","token":"`+token+`","secret":"[REDACTED]"}`; got != want {
+		t.Errorf("JSON() = %q, want %q", got, want)
+	}
+}
+
+func TestGetDiffSensitive(t *testing.T) {
+	useMasker(t, "initial", KeepMasker(1, 0))
+	old := maskUser{Phone: "13812345678", Password: "old"}
+	tests := []struct {
+		name string
+		new  maskUser
+		want string
+	}{
+		{"unchanged", old, ""},
+		{"same mask, different raw value", maskUser{Phone: "13899995678", Password: "old"}, "phone[138****5678=>138****5678]"},
+		{"redacted", maskUser{Phone: "13812345678", Password: "new"}, "password[[REDACTED]=>[REDACTED]]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetDiff(old, tt.new).Log(); got != tt.want {
+				t.Fatalf("GetDiff().Log() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetFieldsHMAC(t *testing.T) {
+	type session struct {
+		Token string `log:"token,hash:hmac-sha256"`
+		Other string `log:"other,hash:hmac-crc32"`
+	}
+	req := session{"t0k3n", "t0k3n"}
+	if got, want := GetFields(req).Log(), "token[[REDACTED]],other[[REDACTED]]"; got != want {
+		t.Fatalf("Log() without key = %q, want %q", got, want)
+	}
+
+	SetHashKey([]byte("k3y"))
+	t.Cleanup(func() { SetHashKey(nil) })
+	mac := hmac.New(sha256.New, []byte("k3y"))
+	mac.Write([]byte("t0k3n"))
+	if got, want := GetFields(req).Log(), "token["+hex.EncodeToString(mac.Sum(nil))+"],other[[REDACTED]]"; got != want {
+		t.Fatalf("Log() with key = %q, want %q", got, want)
+	}
+}
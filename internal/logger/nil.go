@@ -0,0 +1,107 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import "reflect"
+
+// NilPolicy defines how nil pointers and nil interfaces are logged.
+type NilPolicy int
+
+const (
+	// NilRender logs nil values as "<nil>", or null in JSON. This is the default.
+	NilRender NilPolicy = iota
+	// NilSkip leaves out nil values, as if the field had no log tag.
+	NilSkip
+	// NilEmpty logs nil values as an empty string.
+	NilEmpty
+)
+
+// nilPolicy is the policy set by SetNilPolicy, guarded by settingsMu.
+var nilPolicy = NilRender
+
+// SetNilPolicy sets how nil pointers and nil interfaces are logged by GetFields, so that audit content can tell
+// a value that was not provided from one set to zero. Inline structs are always left out when nil.
+func SetNilPolicy(policy NilPolicy) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	nilPolicy = policy
+}
+
+// getNilPolicy retrieves the policy set by SetNilPolicy.
+func getNilPolicy() NilPolicy {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return nilPolicy
+}
+
+// omitMode defines when a field is left out based on its value, as set by the "omitempty" and "omitzero" tag options.
+type omitMode uint8
+
+const (
+	omitNone  omitMode = iota // omitNone never leaves out the field by its value.
+	omitEmpty                 // omitEmpty leaves out nil, false, 0, and empty strings, arrays/slices and maps.
+	omitZero                  // omitZero leaves out nil and zero values, using the IsZero method if implemented.
+)
+
+// omitted reports whether a value is left out by the omit mode.
+func (m omitMode) omitted(sv reflect.Value) bool {
+	switch m {
+	case omitEmpty:
+		return isNil(sv) || isEmptyValue(sv)
+	case omitZero:
+		return isNil(sv) || isZeroValue(sv)
+	}
+	return false
+}
+
+// isNil reports whether a value is a nil pointer, dereferenced into an invalid value, a nil interface or pointer,
+// or an interface holding a nil pointer, such as an error of a pointer type.
+func isNil(sv reflect.Value) bool {
+	if !sv.IsValid() {
+		return true
+	}
+	switch sv.Kind() {
+	case reflect.Interface:
+		return sv.IsNil() || sv.Elem().Kind() == reflect.Pointer && sv.Elem().IsNil()
+	case reflect.Pointer:
+		return sv.IsNil()
+	}
+	return false
+}
+
+// isEmptyValue reports whether a value is empty, following the omitempty rules of encoding/json.
+func isEmptyValue(sv reflect.Value) bool {
+	switch sv.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return sv.Len() == 0
+	case reflect.Bool:
+		return !sv.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return sv.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return sv.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return sv.Float() == 0
+	}
+	return false
+}
+
+// isZeroValue reports whether a value is zero, using its IsZero method if implemented, such as for time.Time.
+func isZeroValue(sv reflect.Value) bool {
+	// Use a pointer to a copy of the value, so that methods with pointer receivers are found as well.
+	pv := reflect.New(sv.Type())
+	pv.Elem().Set(sv)
+	if z, ok := pv.Interface().(interface{ IsZero() bool }); ok {
+		return z.IsZero()
+	}
+	return sv.IsZero()
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"testing"
+	"time"
+)
+
+// nilInner is a struct logged inline or nested in nil tests.
+type nilInner struct {
+	B int `log:"b"`
+}
+
+// nilReq is a struct exercising nil pointers and interfaces in tests.
+type nilReq struct {
+	Age    *int      `log:"age"`
+	Extra  any       `log:"extra"`
+	Name   string    `log:"name"`
+	Nested *nilInner `log:",inline"`
+}
+
+// omitReq is a struct exercising the omitempty and omitzero tag options in tests.
+type omitReq struct {
+	Note  string          `log:"note,omitempty"`
+	Flag  bool            `log:"flag,omitempty"`
+	Tags  []string        `log:"tags,omitempty"`
+	Age   *int            `log:"age,omitempty"`
+	When  time.Time       `log:"when,omitzero"`
+	Point struct{ X int } `log:"point,omitzero"`
+	Count int             `log:"count"`
+}
+
+// useNilPolicy sets the nil policy for the duration of the test.
+func useNilPolicy(t *testing.T, policy NilPolicy) {
+	SetNilPolicy(policy)
+	t.Cleanup(func() { SetNilPolicy(NilRender) })
+}
+
+func TestSetNilPolicy(t *testing.T) {
+	zero := 0
+	tests := []struct {
+		name   string
+		policy NilPolicy
+		req    nilReq
+		log    string
+		json   string
+	}{
+		{"render", NilRender, nilReq{Name: "a"}, "age[<nil>],extra[<nil>],name[a]", `{"age":null,"extra":null,"name":"a"}`},
+		{"skip", NilSkip, nilReq{Name: "a"}, "name[a]", `{"name":"a"}`},
+		{"empty", NilEmpty, nilReq{Name: "a"}, "age[],extra[],name[a]", `{"age":"","extra":"","name":"a"}`},
+		{"zero is not nil", NilSkip, nilReq{Age: &zero, Extra: 0, Nested: &nilInner{1}}, "age[0],extra[0],name[],b[1]", `{"age":0,"extra":0,"name":"","b":1}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useNilPolicy(t, tt.policy)
+			fs := GetFields(tt.req)
+			if got := fs.Log(); got != tt.log {
+				t.Errorf("Log() = %q, want %q", got, tt.log)
+			}
+			if got := fs.JSON(); got != tt.json {
+				t.Errorf("JSON() = %q, want %q", got, tt.json)
+			}
+		})
+	}
+}
+
+func TestGetFieldsOmit(t *testing.T) {
+	zero := 0
+	when := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name string
+		req  omitReq
+		want string
+	}{
+		{"empty and zero", omitReq{}, "count[0]"},
+		{"pointer to empty value", omitReq{Age: &zero}, "count[0]"},
+		{"set", omitReq{"n", true, []string{"x"}, nil, when, struct{ X int }{1}, 2}, "note[n],flag[true],tags[x],when[2025-01-02 03:04:05],point[X[1]],count[2]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetFields(tt.req).Log(); got != tt.want {
+				t.Fatalf("Log() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
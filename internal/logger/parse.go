@@ -57,85 +57,73 @@ func getSupportedFields(ov reflect.Value, defaultIgnoreS ...bool) (fieldSlice Fi
 
 	// Dereference the input value to handle pointers.
 	ov = rv(ov)
-	numField := 0
-	isStruct := isStruct0(ov)
-	isArray := isArray0(ov)
-
-	// Determine the number of fields to process based on whether it's a struct or array.
-	if isStruct {
-		numField = ov.NumField()
-	} else if isArray {
-		numField = ov.Len()
-	}
-
-	// Iterate over fields or elements.
-	for i := 0; i < numField; i++ {
-		if isStruct {
-			// Process struct fields.
-			fd := ov.Type().Field(i) // Get the struct field definition.
-			sv := rv(ov.Field(i))    // Get the dereferenced field value.
-
-			// Skip unexported fields (not accessible for reflection).
-			if !fd.IsExported() {
-				continue
-			}
-
-			// Skip unsupported field types based on the supportedKind map.
-			if _, supported := supportedKind[sv.Kind()]; !supported {
-				continue
-			}
-
-			// Check if the field is a struct.
-			fieldIsStruct := isStruct0(sv)
-			// Look up the "log" tag in the struct field.
-			logTag, ok := fd.Tag.Lookup("log")
-
-			// Skip fields that are structs or marked for default ignore without a log tag,
-			// or explicitly ignored with a "-" tag.
-			if ((fieldIsStruct || defaultIgnore) && !ok) || logTag == "-" {
-				continue
-			}
-
-			// Handle inline structs, recursively processing their fields.
-			if fieldIsStruct && logTag == ",inline" {
-				fieldSlice = append(fieldSlice, getSupportedFields(sv, defaultIgnore)...)
-				continue
-			}
 
-			// Parse the log tag to extract name, format, and expression.
-			logName, format, expr0 := parseTag(fd, logTag)
-			// Create a Field instance for logging.
-			f := Field{Name: logName, Format: format, expr: expr0, SV: sv, OV: ov}
+	// Iterate over the fields of a struct using its compiled plan.
+	if isStruct0(ov) {
+		for _, fp := range getStructPlan(ov.Type(), defaultIgnore).fields {
+			fieldSlice = append(fieldSlice, getPlanFields(ov, fp, defaultIgnore)...)
+		}
+		return
+	}
 
-			// Handle nested structs or arrays/slices of structs without an explicit expression.
-			if (fieldIsStruct && expr0 == nil) || ((sv.Kind() == reflect.Array || sv.Kind() == reflect.Slice) && fd.Type.Elem().Kind() == reflect.Struct) {
-				f.expr = newExprFields(getSupportedFields(sv, defaultIgnore))
-			}
-			fieldSlice = append(fieldSlice, f)
-		} else if isArray {
-			// Process array or slice elements.
+	// Iterate over the elements of an array or slice.
+	if isArray0(ov) {
+		for i := 0; i < ov.Len(); i++ {
 			ev := ov.Index(i)      // Get the element at index i.
 			et := ev.Type().Kind() // Get the element's type.
 			if _, supported := supportedKind[et]; !supported {
 				continue
 			}
-			// Create a Field instance for the array element, using arrayElementFormat and recursive field extraction.
-			f := Field{Format: arrayElementFormat, expr: newExprFields(getSupportedFields(ev, defaultIgnore)), SV: ev, OV: ev}
+			// Create a Field instance for the array element, using the default element format and recursive field extraction.
+			f := Field{expr: newExprFields(getSupportedFields(ev, defaultIgnore)), SV: ev, OV: ev}
 			fieldSlice = append(fieldSlice, f)
 		}
 	}
 	return
 }
 
+// getPlanFields extracts the fields to log for a single struct field of ov, following its compiled plan.
+// It returns no field for a value that is left out, and the fields of the struct for an inline struct.
+func getPlanFields(ov reflect.Value, fp fieldPlan, defaultIgnore bool) (fieldSlice FieldSlice) {
+	// Get the dereferenced field value, which is invalid for nil pointers.
+	// Interface fields are unwrapped to their dynamic value, whose type decides whether it is nested,
+	// and are skipped if the dynamic value is not supported.
+	sv, nested := fp.unwrap(rv(ov.Field(fp.index)))
+	if fp.dynamic && !sv.IsValid() || fp.omit.omitted(sv) {
+		return
+	}
+
+	// Handle nil pointers and interfaces according to the nil policy.
+	if isNil(sv) {
+		if policy := getNilPolicy(); !fp.inline && policy != NilSkip {
+			fieldSlice = append(fieldSlice, Field{Name: fp.name, Format: fp.format, expr: newExprNil(policy), SV: sv, OV: ov})
+		}
+		return
+	}
+
+	// Handle inline structs, recursively processing their fields.
+	if fp.inline {
+		return getSupportedFields(sv, defaultIgnore)
+	}
+
+	// Create a Field instance for logging.
+	f := Field{Name: fp.name, Format: fp.format, expr: fp.expr, SV: sv, OV: ov}
+
+	// Handle nested structs or arrays/slices of structs.
+	if nested {
+		f.expr = newExprFields(getSupportedFields(sv, defaultIgnore))
+	}
+	return append(fieldSlice, f)
+}
+
 // parseTag parses a struct field's log tag to extract the log name, format, and expression.
 // The log tag is expected to be in the format "name,option1,option2" where options can include
-// "ref:<path>", "transform:<mapping>", or a custom format string.
-func parseTag(fd reflect.StructField, logTag string) (logName, format string, expr0 expr) {
-	logName = fd.Name    // Default to the field name.
-	format = fieldFormat // Default to the predefined field format.
-	tagS := strings.Split(logTag, ",")
-	for i, tag := range tagS {
-		if tag = strings.TrimSpace(tag); tag == "" {
+// "ref:<path>", "transform:<mapping>", "time:<layout>", "tz:<zone>", "duration:<mode>",
+// "stringer", "omitempty", "omitzero", "mask:<masker>", "redact", "hash:<algorithm>", or a custom format string.
+func parseTag(fd reflect.StructField, logTag string) (logName, format string, expr0 expr, omit omitMode) {
+	logName = fd.Name // Default to the field name, and leave the format empty for the renderer's default.
+	for i, tag := range splitTag(logTag) {
+		if tag == "" {
 			continue
 		}
 		if i == 0 {
@@ -147,6 +135,40 @@ func parseTag(fd reflect.StructField, logTag string) (logName, format string, ex
 		} else if strings.HasPrefix(tag, "transform:") {
 			// Handle transformation expression (e.g., "transform:1->on|2->off").
 			expr0 = newExprTransform(tag[10:])
+		} else if strings.HasPrefix(tag, "mask:") {
+			// Handle masking expression (e.g., "mask:phone" or "mask:keep(3,4)").
+			expr0 = newExprMask(tag[5:])
+		} else if strings.HasPrefix(tag, "time:") || strings.HasPrefix(tag, "tz:") {
+			// Handle time layout and zone options (e.g., "time:2006-01-02 15:04" and "tz:Asia/Shanghai"),
+			// which combine into a single time expression.
+			et, ok := expr0.(*exprTime)
+			if !ok {
+				et = newExprTime()
+				expr0 = et
+			}
+			if strings.HasPrefix(tag, "time:") {
+				et.setLayout(tag[5:])
+			} else {
+				et.setZone(tag[3:])
+			}
+		} else if strings.HasPrefix(tag, "duration:") {
+			// Handle duration rendering option (e.g., "duration:human").
+			expr0 = newExprDuration(tag[9:])
+		} else if tag == "omitempty" {
+			// Handle omitempty option, which leaves out empty values.
+			omit = omitEmpty
+		} else if tag == "omitzero" {
+			// Handle omitzero option, which leaves out zero values.
+			omit = omitZero
+		} else if tag == "stringer" {
+			// Handle stringer expression, which logs the value by its String or MarshalText method.
+			expr0 = newExprStringer()
+		} else if tag == "redact" {
+			// Handle redaction expression, which hides the value entirely.
+			expr0 = newExprRedact()
+		} else if strings.HasPrefix(tag, "hash:") {
+			// Handle hashing expression (e.g., "hash:sha256").
+			expr0 = newExprHash(tag[5:])
 		} else {
 			// Any other tag is treated as the format string.
 			format = tag
@@ -155,6 +177,69 @@ func parseTag(fd reflect.StructField, logTag string) (logName, format string, ex
 	return
 }
 
+// splitTag splits a log tag into its name and options, trimming surrounding spaces.
+// Commas within parentheses, such as in "mask:keep(3,4)", do not split options,
+// and neither do commas between the keys of a transform branch, such as in "transform:1,2,3->active".
+// The first element is always the log name, which may be empty.
+func splitTag(logTag string) (tagS []string) {
+	depth, start := 0, 0
+	for i, r := range logTag {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')' && depth > 0:
+			depth--
+		case r == ',' && depth == 0:
+			tagS = appendTag(tagS, logTag[start:i])
+			start = i + 1
+		}
+	}
+	return appendTag(tagS, logTag[start:])
+}
+
+// appendTag appends a trimmed option to the split tag, joining it to the previous option instead
+// if that is a transform mapping whose last branch has keys but no "->" yet.
+func appendTag(tagS []string, tag string) []string {
+	if n := len(tagS); n > 1 && strings.HasPrefix(tagS[n-1], "transform:") {
+		mapping := strings.TrimSpace(tagS[n-1][10:])
+		branch := mapping[strings.LastIndexByte(mapping, '|')+1:]
+		if mapping != "" && mapping[0] != '@' && mapping != "enum" && !strings.Contains(branch, "->") {
+			tagS[n-1] += "," + strings.TrimSpace(tag)
+			return tagS
+		}
+	}
+	return append(tagS, strings.TrimSpace(tag))
+}
+
+// dynamicValue unwraps the value of an interface field to its dynamic value, dereferencing pointers.
+// Errors are kept as error values, so that they are logged by their message,
+// and nil interfaces and nil pointers are returned as is, so that they are logged as nil.
+func dynamicValue(v reflect.Value) reflect.Value {
+	if v.Kind() != reflect.Interface || v.IsNil() {
+		return v
+	}
+	v = v.Elem()
+	if v.Type().Implements(errorType) {
+		ev := reflect.New(errorType).Elem()
+		ev.Set(v)
+		return ev
+	}
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	return v
+}
+
+// isDynamicSupported reports whether the dynamic value of an interface field is logged:
+// nil values, values of supported kinds, and scalar values.
+func isDynamicSupported(v reflect.Value) bool {
+	if (v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer) && v.IsNil() {
+		return true
+	}
+	_, supported := supportedKind[v.Kind()]
+	return supported || isScalar(v.Type())
+}
+
 // rv dereferences a reflect.Value until a non-pointer type is reached.
 // This ensures the value is usable for reflection operations.
 func rv(v reflect.Value) (vv reflect.Value) {
@@ -166,24 +251,26 @@ func rv(v reflect.Value) (vv reflect.Value) {
 }
 
 // supportedKind is a map of reflect.Kind types that are supported for logging.
-// It includes basic types (bool, integers, floats, string), structs, arrays, slices, and maps.
+// It includes basic types (bool, integers, floats, string), structs, arrays, slices, maps,
+// and interfaces, whose dynamic values are checked when they are logged.
 var supportedKind = map[reflect.Kind]struct{}{
-	reflect.Bool:    {},
-	reflect.Int:     {},
-	reflect.Int8:    {},
-	reflect.Int16:   {},
-	reflect.Int32:   {},
-	reflect.Int64:   {},
-	reflect.Uint:    {},
-	reflect.Uint8:   {},
-	reflect.Uint16:  {},
-	reflect.Uint32:  {},
-	reflect.Uint64:  {},
-	reflect.Float32: {},
-	reflect.Float64: {},
-	reflect.Array:   {},
-	reflect.Map:     {},
-	reflect.Slice:   {},
-	reflect.String:  {},
-	reflect.Struct:  {},
+	reflect.Bool:      {},
+	reflect.Int:       {},
+	reflect.Int8:      {},
+	reflect.Int16:     {},
+	reflect.Int32:     {},
+	reflect.Int64:     {},
+	reflect.Uint:      {},
+	reflect.Uint8:     {},
+	reflect.Uint16:    {},
+	reflect.Uint32:    {},
+	reflect.Uint64:    {},
+	reflect.Float32:   {},
+	reflect.Float64:   {},
+	reflect.Array:     {},
+	reflect.Map:       {},
+	reflect.Slice:     {},
+	reflect.String:    {},
+	reflect.Struct:    {},
+	reflect.Interface: {},
 }
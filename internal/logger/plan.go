@@ -0,0 +1,173 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"reflect"
+	"sync"
+)
+
+// fieldPlan is the compiled logging plan of a single struct field, derived from its type and log tag.
+type fieldPlan struct {
+	index   int      // index is the field index within the struct.
+	name    string   // name is the log name of the field.
+	format  string   // format is the custom format string, empty for the renderer's default.
+	expr    expr     // expr is the parsed tag expression, such as ref or transform, nil if none.
+	inline  bool     // inline marks a struct field whose fields are logged as part of the parent struct.
+	nested  bool     // nested marks a struct or array/slice of structs field whose fields are logged recursively.
+	dynamic bool     // dynamic marks an interface field, whose dynamic value decides how it is logged.
+	omit    omitMode // omit tells when the field is left out based on its value.
+}
+
+// structPlan is the compiled logging plan of a struct type, listing the fields to log in order.
+type structPlan struct {
+	fields []fieldPlan
+}
+
+// planKey identifies a compiled plan by struct type, default ignore behavior, and settings generation.
+type planKey struct {
+	typ           reflect.Type
+	defaultIgnore bool
+	gen           uint64
+}
+
+// planCache caches the compiled plans by planKey, so that struct tags are parsed once per type.
+var planCache sync.Map
+
+// planGen is the generation of the settings the compiled plans depend on, guarded by settingsMu.
+// A plan compiled while the settings change is stored under an outdated generation, so it is never used.
+var planGen uint64
+
+// getStructPlan retrieves the compiled plan of a struct type, compiling and caching it on first use.
+func getStructPlan(typ reflect.Type, defaultIgnore bool) *structPlan {
+	key := planKey{typ, defaultIgnore, getPlanGen()}
+	if plan, ok := planCache.Load(key); ok {
+		return plan.(*structPlan)
+	}
+	plan, _ := planCache.LoadOrStore(key, compileStructPlan(typ, defaultIgnore))
+
+	// Drop the plan if the settings changed while it was compiled, since it can no longer be looked up.
+	if getPlanGen() != key.gen {
+		planCache.Delete(key)
+	}
+	return plan.(*structPlan)
+}
+
+// getPlanGen retrieves the current settings generation of the compiled plans.
+func getPlanGen() uint64 {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return planGen
+}
+
+// compileStructPlan parses the log tags of a struct type into a plan.
+// It applies the static rules of getSupportedFields: unexported fields, unsupported kinds,
+// structs or default-ignored fields without a log tag, and fields tagged "-" are left out.
+// Fields matching the redaction policy are redacted.
+func compileStructPlan(typ reflect.Type, defaultIgnore bool) *structPlan {
+	plan := &structPlan{}
+	for i := 0; i < typ.NumField(); i++ {
+		fd := typ.Field(i) // Get the struct field definition.
+
+		// Skip unexported fields (not accessible for reflection).
+		if !fd.IsExported() {
+			continue
+		}
+
+		// Skip unsupported field types based on the supportedKind map, dereferencing pointer types,
+		// except scalar types such as time.Time, error, and types with a registered formatter.
+		kind := rt(fd.Type).Kind()
+		scalar := isScalar(rt(fd.Type))
+		if _, supported := supportedKind[kind]; !supported && !scalar {
+			continue
+		}
+
+		// Check if the field is a struct other than a scalar, and look up the "log" tag in the struct field.
+		fieldIsStruct := kind == reflect.Struct && !scalar
+		logTag, ok := fd.Tag.Lookup("log")
+
+		// Skip fields that are structs or marked for default ignore without a log tag,
+		// or explicitly ignored with a "-" tag.
+		if ((fieldIsStruct || defaultIgnore) && !ok) || logTag == "-" {
+			continue
+		}
+
+		// Handle inline structs, whose fields are processed recursively.
+		if fieldIsStruct && logTag == ",inline" {
+			plan.fields = append(plan.fields, fieldPlan{index: i, name: fd.Name, inline: true})
+			continue
+		}
+
+		// Parse the log tag to extract name, format, and expression.
+		logName, format, expr0, omit := parseTag(fd, logTag)
+
+		// Redact fields matching the redaction policy, unless they are already masked.
+		if _, masked := expr0.(maskExpr); !masked && shouldRedact(fd.Name, logName) {
+			expr0 = newExprRedact()
+		}
+		fp := fieldPlan{index: i, name: logName, format: format, expr: expr0, omit: omit}
+
+		// Handle nested structs or arrays/slices of structs without an explicit expression,
+		// so that masking expressions also apply to them as a whole.
+		fp.nested = expr0 == nil && isNested(fd.Type)
+
+		// Handle interface fields other than scalars such as error.
+		fp.dynamic = kind == reflect.Interface && !scalar
+		plan.fields = append(plan.fields, fp)
+	}
+	return plan
+}
+
+// unwrap unwraps the value of an interface field to its dynamic value, and tells whether it is logged recursively.
+// It returns an invalid value if the dynamic value is not logged. Other fields are returned as is.
+func (fp fieldPlan) unwrap(v reflect.Value) (sv reflect.Value, nested bool) {
+	if !fp.dynamic {
+		return v, fp.nested
+	}
+	if sv = dynamicValue(v); !isDynamicSupported(sv) {
+		return reflect.Value{}, false
+	}
+	isNil := (sv.Kind() == reflect.Interface || sv.Kind() == reflect.Pointer) && sv.IsNil()
+	return sv, fp.expr == nil && !isNil && isNested(sv.Type())
+}
+
+// isNested reports whether values of a type are logged recursively:
+// structs and arrays/slices of structs, dereferencing pointer types, except scalars.
+func isNested(t reflect.Type) bool {
+	switch t = rt(t); t.Kind() {
+	case reflect.Struct:
+		return !isScalar(t)
+	case reflect.Array, reflect.Slice:
+		return t.Elem().Kind() == reflect.Struct && !isScalar(t.Elem())
+	}
+	return false
+}
+
+// resetPlans clears the compiled plans, after a change to the settings they depend on.
+// It advances the settings generation first, so that plans being compiled concurrently are not reused.
+func resetPlans() {
+	settingsMu.Lock()
+	planGen++
+	settingsMu.Unlock()
+	planCache.Range(func(key, _ any) bool {
+		planCache.Delete(key)
+		return true
+	})
+}
+
+// rt dereferences a reflect.Type until a non-pointer type is reached.
+func rt(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
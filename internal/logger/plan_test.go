@@ -0,0 +1,102 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"reflect"
+	"testing"
+)
+
+// benchItem is a nested struct logged recursively in benchmarks.
+type benchItem struct {
+	Sku   string  `log:"sku"`
+	Count int     `log:"count"`
+	Price float64 `log:"price"`
+}
+
+// benchOrder is a struct exercising the common log tag options in benchmarks.
+type benchOrder struct {
+	Id       uint              `log:"id"`
+	Status   int               `log:"status,transform:1->paid|2->shipped|*->other"`
+	Remark   string            `log:"remark,%s(%s)"`
+	Items    []benchItem       `log:"items"`
+	Buyer    benchItem         `log:"buyer"`
+	Tags     map[string]string `log:"tags"`
+	Internal string            `log:"-"`
+}
+
+// newBenchOrder returns a benchOrder with all logged fields set.
+func newBenchOrder() benchOrder {
+	return benchOrder{
+		Id:     42,
+		Status: 2,
+		Remark: "express",
+		Items:  []benchItem{{"a-1", 2, 9.5}, {"b-2", 1, 20}},
+		Buyer:  benchItem{"c-3", 1, 5},
+		Tags:   map[string]string{"channel": "web", "region": "eu"},
+	}
+}
+
+// BenchmarkGetFields compares GetFields with the plan cache cleared before each call (cold)
+// against GetFields reusing the cached plans (warm).
+func BenchmarkGetFields(b *testing.B) {
+	order := newBenchOrder()
+	b.Run("cold", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			resetPlans()
+			_ = GetFields(order)
+		}
+	})
+	b.Run("warm", func(b *testing.B) {
+		b.ReportAllocs()
+		_ = GetFields(order)
+		for i := 0; i < b.N; i++ {
+			_ = GetFields(order)
+		}
+	})
+}
+
+func TestGetFieldsCachedPlan(t *testing.T) {
+	resetPlans()
+	order := newBenchOrder()
+	order.Tags = map[string]string{"channel": "web"}
+	want := "id[42],status[shipped],remark(express),items[{sku[a-1],count[2],price[9.5]},{sku[b-2],count[1],price[20]}],buyer[sku[c-3],count[1],price[5]],tags[channel:web]"
+	for i := 0; i < 2; i++ {
+		if got := GetFields(order).Log(); got != want {
+			t.Fatalf("GetFields() call %d = %q, want %q", i+1, got, want)
+		}
+	}
+	if _, ok := planCache.Load(planKey{typ: reflect.TypeOf(order), gen: getPlanGen()}); !ok {
+		t.Fatal("plan of benchOrder not cached")
+	}
+}
+
+func TestGetFieldsIgnoresStalePlan(t *testing.T) {
+	type account struct {
+		Password string `log:"password"`
+	}
+	typ := reflect.TypeOf(account{})
+	defer func() { _ = SetRedactionPolicy() }()
+
+	// Compile a plan before the redaction policy changes, and store it after, like a concurrent GetFields would.
+	gen := getPlanGen()
+	stale := compileStructPlan(typ, false)
+	if err := SetRedactionPolicy("password"); err != nil {
+		t.Fatal(err)
+	}
+	planCache.LoadOrStore(planKey{typ, false, gen}, stale)
+
+	if got, want := GetFields(account{"secret"}).Log(), "password["+Redacted+"]"; got != want {
+		t.Fatalf("GetFields().Log() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// redactionPatterns is the redaction policy set by SetRedactionPolicy, as lowercase patterns guarded by settingsMu.
+var redactionPatterns []string
+
+// SetRedactionPolicy sets the name patterns of the fields that are always redacted, such as "password",
+// "secret", "token" or "*_key". Patterns use the syntax of path.Match and are matched case-insensitively
+// against the struct field names and log names of all structs processed by GetFields and GetDiff,
+// including nested structs, and against the keys of maps rendered by the default MapFunc and as JSON.
+// Fields with a mask, redact or hash tag option keep their own masking.
+// Calling SetRedactionPolicy without patterns disables the policy. Returns an error if a pattern is malformed.
+func SetRedactionPolicy(patterns ...string) error {
+	// Validate the patterns and normalize them to lowercase.
+	lowerS := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		lower := strings.ToLower(pattern)
+		if _, err := path.Match(lower, ""); err != nil {
+			return fmt.Errorf("unilog: invalid redaction pattern %q: %w", pattern, err)
+		}
+		lowerS = append(lowerS, lower)
+	}
+
+	settingsMu.Lock()
+	redactionPatterns = lowerS
+	settingsMu.Unlock()
+
+	// Clear the compiled plans, which have the previous policy applied.
+	resetPlans()
+	return nil
+}
+
+// shouldRedact reports whether any of the names matches the redaction policy.
+func shouldRedact(names ...string) bool {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	for _, pattern := range redactionPatterns {
+		for _, name := range names {
+			if ok, _ := path.Match(pattern, strings.ToLower(name)); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import "testing"
+
+// redactCredentials is a nested struct with fields matched by the redaction policy in tests.
+type redactCredentials struct {
+	ApiKey string `log:"api_key"`
+	Region string `log:"region"`
+}
+
+// redactAccount is a struct exercising the redaction policy in tests.
+type redactAccount struct {
+	User     string            `log:"user"`
+	Password string            `log:"pwd"`
+	Phone    string            `log:"phone,mask:phone"`
+	Creds    redactCredentials `log:"creds"`
+	Headers  map[string]string `log:"headers"`
+}
+
+// useRedactionPolicy sets the redaction policy for the duration of the test.
+func useRedactionPolicy(t *testing.T, patterns ...string) {
+	if err := SetRedactionPolicy(patterns...); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = SetRedactionPolicy() })
+}
+
+func TestSetRedactionPolicy(t *testing.T) {
+	useRedactionPolicy(t, "password", "*_KEY", "phone", "authorization")
+	account := redactAccount{"kellen", "p@ss", "13812345678", redactCredentials{"k-123", "eu"}, map[string]string{"Authorization": "Bearer x"}}
+	fs := GetFields(account)
+	if got, want := fs.Log(), "user[kellen],pwd[[REDACTED]],phone[138****5678],creds[api_key[[REDACTED]],region[eu]],headers[Authorization:[REDACTED]]"; got != want {
+		t.Errorf("Log() = %q, want %q", got, want)
+	}
+	if got, want := fs.JSON(), `{"user":"kellen","pwd":"[REDACTED]","phone":"138****5678","creds":{"api_key":"[REDACTED]","region":"eu"},"headers":{"Authorization":"[REDACTED]"}}`; got != want {
+		t.Errorf("JSON() = %q, want %q", got, want)
+	}
+}
+
+func TestSetRedactionPolicyDisabled(t *testing.T) {
+	useRedactionPolicy(t, "password")
+	useRedactionPolicy(t)
+	if got, want := GetFields(redactAccount{Password: "p@ss"}).Log(), "user[],pwd[p@ss],phone[],creds[api_key[],region[]],headers[]"; got != want {
+		t.Fatalf("Log() = %q, want %q", got, want)
+	}
+}
+
+func TestSetRedactionPolicyInvalid(t *testing.T) {
+	if err := SetRedactionPolicy("[a-"); err == nil {
+		t.Fatal("SetRedactionPolicy() error = nil, want malformed pattern error")
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// BracketRenderer renders fields in the bracket style (e.g., "Name[Kellen],obj[Name[Kellen]]").
+// Zero-valued options fall back to the package-level settings, such as those set by SetFieldFormat.
+type BracketRenderer struct {
+	FieldFormat        string     // FieldFormat is the format string for fields without a custom format (e.g., "%s[%v]").
+	ArrayElementFormat string     // ArrayElementFormat is the format string for array/slice elements (e.g., "{%v}").
+	DiffFormat         string     // DiffFormat is the format string for changed fields (e.g., "%s[%v=>%v]").
+	JoinSep            string     // JoinSep is the separator for joining multiple field log strings (e.g., ",").
+	ArrayFunc          ArrayFunc  // ArrayFunc formats array/slice values.
+	MapFunc            MapFunc    // MapFunc formats map values.
+	mapFormat          *mapFormat // mapFormat is the built-in map formatting behind the default MapFunc, nil for a custom one.
+}
+
+// Render generates a concatenated string of log entries from all fields in the slice, joined by a separator.
+func (r BracketRenderer) Render(fs FieldSlice) (str string) {
+	r = r.resolve()
+	var strS []string
+	for _, f := range fs {
+		if fStr := r.field(f); fStr != "" {
+			strS = append(strS, fStr)
+		}
+	}
+	return strings.Join(strS, r.JoinSep)
+}
+
+// resolve returns a copy of the renderer with zero-valued options set to the package-level settings.
+func (r BracketRenderer) resolve() BracketRenderer {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	r.FieldFormat = pick(r.FieldFormat, fieldFormat)
+	r.ArrayElementFormat = pick(r.ArrayElementFormat, arrayElementFormat)
+	r.DiffFormat = pick(r.DiffFormat, diffFormat)
+	r.JoinSep = pick(r.JoinSep, fieldJoinSep)
+	if r.ArrayFunc == nil {
+		r.ArrayFunc = arrayFunc0
+	}
+	if r.MapFunc == nil {
+		r.MapFunc, r.mapFormat = mapFunc0, mapFormat0
+	}
+	return r
+}
+
+// field generates a formatted log string based on the field's format and evaluated values.
+func (r BracketRenderer) field(f Field) (str string) {
+	format := r.format(f)
+	var values []any
+	if f.Name != "" {
+		values = append(values, f.Name)
+	}
+	switch e := f.expr.(type) {
+	case nil:
+		values = append(values, r.value(f.SV))
+	case *exprFields:
+		// Render nested fields with the same renderer.
+		values = append(values, r.Render(e.FieldSlice))
+	default:
+		values = append(values, e.Expr(format, f.OV, f.SV)...)
+	}
+	return fmt.Sprintf(format, values...)
+}
+
+// format resolves the format string of a field, using the renderer's defaults for fields without a custom format.
+func (r BracketRenderer) format(f Field) string {
+	switch {
+	case f.Format != "":
+		return f.Format
+	case f.Name == "":
+		return r.ArrayElementFormat
+	}
+	if _, ok := f.expr.(*exprDiff); ok {
+		return r.DiffFormat
+	}
+	return r.FieldFormat
+}
+
+// value retrieves a value based on its type, handling built-in types, basic types, arrays/slices, and maps.
+// The renderer must have been resolved.
+func (r BracketRenderer) value(sv reflect.Value) (v any) {
+	if v, ok := scalarValue(sv); ok {
+		return v
+	}
+	vk := sv.Kind()
+	switch {
+	case vk >= reflect.Bool && vk <= reflect.Float64 || vk == reflect.String || vk == reflect.Struct:
+		return sv.Interface()
+	case vk == reflect.Array || vk == reflect.Slice:
+		return r.ArrayFunc(sv)
+	case vk == reflect.Map:
+		// Render the values of the built-in map formatting with this renderer rather than the package-level settings.
+		if r.mapFormat != nil {
+			return r.mapFormat.render(r, sv)
+		}
+		return r.MapFunc(sv)
+	}
+	return
+}
+
+// pick returns the given string, or the fallback if it is empty.
+func pick(s, fallback string) string {
+	if s != "" {
+		return s
+	}
+	return fallback
+}
@@ -0,0 +1,20 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+// JSONRenderer renders fields as a JSON object, as generated by FieldSlice.JSON.
+type JSONRenderer struct{}
+
+// Render generates a JSON object from all fields in the slice.
+func (JSONRenderer) Render(fs FieldSlice) (str string) {
+	return fs.JSON()
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// LogfmtRenderer renders fields as space-separated key=value pairs (e.g., `String=Polo obj.Name=Kellen`).
+// Nested objects and arrays are flattened into dotted keys, and values containing spaces, quotes,
+// or equal signs are quoted.
+type LogfmtRenderer struct{}
+
+// Render generates key=value pairs from all fields in the slice.
+func (LogfmtRenderer) Render(fs FieldSlice) (str string) {
+	var pairs []string
+	logfmtFlatten(&pairs, "", fs.jsonObject())
+	return strings.Join(pairs, " ")
+}
+
+// logfmtFlatten appends the key=value pairs of a structured value, prefixing nested keys with their parent key.
+func logfmtFlatten(pairs *[]string, key string, value any) {
+	join := func(k string) string {
+		if key == "" {
+			return k
+		}
+		return key + "." + k
+	}
+	switch v := value.(type) {
+	case jsonObject:
+		for _, m := range v {
+			logfmtFlatten(pairs, join(m.name), m.value)
+		}
+	case []any:
+		for i, e := range v {
+			logfmtFlatten(pairs, join(strconv.Itoa(i)), e)
+		}
+	case nil:
+		*pairs = append(*pairs, key+"=")
+	default:
+		*pairs = append(*pairs, key+"="+logfmtQuote(fmt.Sprintf("%v", v)))
+	}
+}
+
+// logfmtQuote quotes a value if it is empty or contains spaces, quotes, or equal signs.
+func logfmtQuote(s string) string {
+	if s == "" || strings.ContainsAny(s, " =\"\t\r\n") {
+		return strconv.Quote(s)
+	}
+	return s
+}
@@ -0,0 +1,49 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import "testing"
+
+// renderItem is a struct logged as an array element in renderer tests.
+type renderItem struct {
+	Sku string `log:"sku"`
+}
+
+// renderOrder is a struct exercising nested structs, arrays and transforms in renderer tests.
+type renderOrder struct {
+	Id     uint         `log:"id"`
+	Remark string       `log:"remark"`
+	Status int          `log:"status,transform:1->paid|2->shipped"`
+	Items  []renderItem `log:"items"`
+	Buyer  renderItem   `log:"buyer"`
+}
+
+func TestRenderers(t *testing.T) {
+	fs := GetFields(renderOrder{Id: 1, Remark: "leave at door", Status: 2, Items: []renderItem{{"a"}, {"b"}}, Buyer: renderItem{"k"}})
+	tests := []struct {
+		name string
+		r    Renderer
+		want string
+	}{
+		{"bracket", BracketRenderer{}, "id[1],remark[leave at door],status[shipped],items[{sku[a]},{sku[b]}],buyer[sku[k]]"},
+		{"logfmt", LogfmtRenderer{}, `id=1 remark="leave at door" status.value=2 status.transformed=shipped items.0.sku=a items.1.sku=b buyer.sku=k`},
+		{"json", JSONRenderer{}, `{"id":1,"remark":"leave at door","status":{"value":2,"transformed":"shipped"},"items":[{"sku":"a"},{"sku":"b"}],"buyer":{"sku":"k"}}`},
+		{"text", TextRenderer{}, "id: 1\nremark: leave at door\nstatus:\n  value: 2\n  transformed: shipped\nitems:\n  [0]:\n    sku: a\n  [1]:\n    sku: b\nbuyer:\n  sku: k"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fs.Render(tt.r); got != tt.want {
+				t.Fatalf("Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TextRenderer renders fields in a human-readable multi-line form, one "name: value" line per field,
+// with nested objects and arrays indented below their parent.
+type TextRenderer struct {
+	Indent string // Indent is the indentation of each nesting level, defaults to two spaces.
+}
+
+// Render generates the multi-line text from all fields in the slice.
+func (r TextRenderer) Render(fs FieldSlice) (str string) {
+	var lines []string
+	r.lines(&lines, 0, fs.jsonObject())
+	return strings.Join(lines, "\n")
+}
+
+// lines appends the lines of an object or array at the given nesting level.
+func (r TextRenderer) lines(lines *[]string, level int, value any) {
+	indent := strings.Repeat(pick(r.Indent, "  "), level)
+	line := func(name string, value any) {
+		switch value.(type) {
+		case jsonObject, []any:
+			*lines = append(*lines, indent+name+":")
+			r.lines(lines, level+1, value)
+		default:
+			*lines = append(*lines, indent+name+": "+r.scalar(value))
+		}
+	}
+	switch v := value.(type) {
+	case jsonObject:
+		for _, m := range v {
+			line(m.name, m.value)
+		}
+	case []any:
+		for i, e := range v {
+			line(fmt.Sprintf("[%d]", i), e)
+		}
+	}
+}
+
+// scalar formats a scalar value, rendering nil as "<nil>".
+func (r TextRenderer) scalar(value any) string {
+	if value == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,36 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+// TransformFunc defines a function type for transforming a field value into the value that is logged.
+type TransformFunc func(v any) any
+
+// transforms is the registry of transform functions by name, referenced by the "transform:@<name>" tag option
+// and guarded by settingsMu.
+var transforms = map[string]TransformFunc{}
+
+// RegisterTransform registers a transform function under a name, to be referenced by the "transform:@<name>" tag option,
+// so that enum lookups, currency formatting or ID-to-name resolution can be shared by many structs.
+// Registering a name again replaces the previous function. Functions are looked up when fields are logged,
+// and must be safe for concurrent use.
+func RegisterTransform(name string, fn TransformFunc) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	transforms[name] = fn
+}
+
+// getTransform retrieves a registered transform function by name, returning nil if none is registered.
+func getTransform(name string) TransformFunc {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return transforms[name]
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"fmt"
+	"testing"
+)
+
+// transformOrder is a struct exercising named transform functions in tests.
+type transformOrder struct {
+	Price  int `log:"price,transform:@cents"`
+	Total  int `log:"total,transform:@cents,%s(%s)"`
+	Status int `log:"status,transform:@missing"`
+}
+
+// useTransform registers fn under name for the duration of the test.
+func useTransform(t *testing.T, name string, fn TransformFunc) {
+	RegisterTransform(name, fn)
+	t.Cleanup(func() {
+		settingsMu.Lock()
+		defer settingsMu.Unlock()
+		delete(transforms, name)
+	})
+}
+
+func TestRegisterTransform(t *testing.T) {
+	useTransform(t, "cents", func(v any) any { return fmt.Sprintf("$%.2f", float64(v.(int))/100) })
+	fs := GetFields(transformOrder{1234, 500, 1})
+	if got, want := fs.Log(), "price[$12.34],total($5.00),status[1]"; got != want {
+		t.Errorf("Log() = %q, want %q", got, want)
+	}
+	if got, want := fs.JSON(), `{"price":{"value":1234,"transformed":"$12.34"},"total":{"value":500,"transformed":"$5.00"},"status":{"value":1,"transformed":1}}`; got != want {
+		t.Errorf("JSON() = %q, want %q", got, want)
+	}
+}
+
+func TestRegisterTransformAfterUse(t *testing.T) {
+	type item struct {
+		Kind int `log:"kind,transform:@kind"`
+	}
+	if got, want := GetFields(item{1}).Log(), "kind[1]"; got != want {
+		t.Fatalf("Log() before RegisterTransform = %q, want %q", got, want)
+	}
+	useTransform(t, "kind", func(v any) any { return map[int]string{1: "book"}[v.(int)] })
+	if got, want := GetFields(item{1}).Log(), "kind[book]"; got != want {
+		t.Fatalf("Log() after RegisterTransform = %q, want %q", got, want)
+	}
+}
+
+func TestValidateTransform(t *testing.T) {
+	type item struct {
+		Kind  int `log:"kind,transform:@"`
+		State int `log:"state,transform:@state"`
+	}
+	err := Validate(item{})
+	if err == nil {
+		t.Fatal("Validate() = nil, want transform function errors")
+	}
+	want := "unilog: logger.item.Kind `log:\"kind,transform:@\"`: empty transform function name\n" +
+		"unilog: logger.item.State `log:\"state,transform:@state\"`: unknown transform function \"state\""
+	if got := err.Error(); got != want {
+		t.Fatalf("Validate() = %q, want %q", got, want)
+	}
+}
+
+func TestTransformBranches(t *testing.T) {
+	type score struct {
+		Status int     `log:"status,transform:1,2,3->active|4->off|*->other"`
+		Score  float64 `log:"score,transform:..0->negative|0..60->fail|60..->pass"`
+		Level  int     `log:"level,transform:1->low"`
+	}
+	tests := []struct {
+		in   score
+		want string
+	}{
+		{score{2, 75, 1}, "status[active],score[pass],level[low]"},
+		{score{4, 60, 2}, "status[off],score[fail],level[2]"},
+		{score{9, -1, 0}, "status[other],score[negative],level[0]"},
+	}
+	for _, tt := range tests {
+		if got := GetFields(tt.in).Log(); got != tt.want {
+			t.Errorf("GetFields(%+v).Log() = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestTransformUnmatchedEmptyDefault(t *testing.T) {
+	type item struct {
+		Level int `log:"level,transform:1->low|*->"`
+	}
+	if got, want := GetFields(item{7}).Log(), "level[]"; got != want {
+		t.Fatalf("Log() = %q, want %q", got, want)
+	}
+}
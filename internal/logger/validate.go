@@ -0,0 +1,343 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// TagError describes a problem with the log tag of a struct field.
+type TagError struct {
+	Type  string // Type is the name of the struct type declaring the field.
+	Field string // Field is the name of the struct field.
+	Tag   string // Tag is the log tag of the field.
+	Msg   string // Msg describes the problem.
+}
+
+// Error implements the error interface.
+func (e *TagError) Error() string {
+	return fmt.Sprintf("unilog: %s.%s `log:%q`: %s", e.Type, e.Field, e.Tag, e.Msg)
+}
+
+// ValidationError aggregates all the problems found by Validate, in field order.
+type ValidationError struct {
+	Errs []*TagError
+}
+
+// Error implements the error interface, listing one problem per line.
+func (e *ValidationError) Error() string {
+	msgS := make([]string, 0, len(e.Errs))
+	for _, err := range e.Errs {
+		msgS = append(msgS, err.Error())
+	}
+	return strings.Join(msgS, "\n")
+}
+
+// Unwrap returns the individual problems, which errors.Is and errors.As traverse from Go 1.20.
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errs))
+	for _, err := range e.Errs {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// As matches the individual problems against target, so that errors.As can match a TagError
+// on Go versions before 1.20, which do not traverse Unwrap() []error.
+func (e *ValidationError) As(target any) bool {
+	for _, err := range e.Errs {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the log tags of a struct type, including its nested structs and arrays/slices of structs.
+// It reports misplaced or unknown options, ref paths that do not resolve, malformed transform mappings,
+// and formats with the wrong number of placeholders. It returns a *ValidationError listing all
+// problems, or nil if the tags are valid. Validate is meant to be called from init functions or tests.
+func Validate(struct0 any) error {
+	// Dereference pointer types and ensure the type is a struct.
+	typ := reflect.TypeOf(struct0)
+	if typ == nil || rt(typ).Kind() != reflect.Struct {
+		return errors.New("unilog: the struct value is not supported")
+	}
+
+	// Collect the problems of the struct and its nested struct types.
+	var errs []*TagError
+	validateStruct(rt(typ), map[reflect.Type]bool{}, &errs)
+	if len(errs) > 0 {
+		return &ValidationError{errs}
+	}
+	return nil
+}
+
+// validateStruct checks the log tags of a struct type, appending problems to errs,
+// and recurses into the nested struct types that are logged. The seen set guards against cycles.
+func validateStruct(typ reflect.Type, seen map[reflect.Type]bool, errs *[]*TagError) {
+	if seen[typ] {
+		return
+	}
+	seen[typ] = true
+
+	for i := 0; i < typ.NumField(); i++ {
+		fd := typ.Field(i)
+		logTag, ok := fd.Tag.Lookup("log")
+		if logTag == "-" {
+			continue
+		}
+		ft := rt(fd.Type)
+		kind := ft.Kind()
+		scalar := isScalar(ft)
+		_, supported := supportedKind[kind]
+		supported = supported || scalar
+
+		// Check the tag, reporting fields that have a log tag but are never logged.
+		if ok {
+			var msgS []string
+			switch {
+			case !fd.IsExported():
+				msgS = []string{"unexported field is never logged"}
+			case !supported:
+				msgS = []string{fmt.Sprintf("field of type %s is never logged", fd.Type)}
+			default:
+				msgS = append(checkTag(logTag, kind == reflect.Struct && !scalar, refResolver(typ), true), checkFieldType(logTag, ft)...)
+			}
+			for _, msg := range msgS {
+				*errs = append(*errs, &TagError{Type: typ.String(), Field: fd.Name, Tag: logTag, Msg: msg})
+			}
+		}
+		if !fd.IsExported() || !supported || scalar {
+			continue
+		}
+
+		// Recurse into tagged struct fields and arrays/slices of structs.
+		if kind == reflect.Struct && ok {
+			validateStruct(ft, seen, errs)
+		} else if (kind == reflect.Array || kind == reflect.Slice) && rt(ft.Elem()).Kind() == reflect.Struct && !isScalar(rt(ft.Elem())) {
+			validateStruct(rt(ft.Elem()), seen, errs)
+		}
+	}
+}
+
+// refResolver returns a function that checks a ref path against a struct type,
+// following the rules of exprRef: intermediate fields must be structs, not pointers,
+// and the target field must be exported.
+func refResolver(typ reflect.Type) func(path string) error {
+	return func(path string) error {
+		t := typ
+		segS := strings.Split(strings.TrimPrefix(path, "."), ".")
+		for i, seg := range segS {
+			if seg == "" {
+				return errors.New("empty path segment")
+			}
+			if t.Kind() != reflect.Struct {
+				return fmt.Errorf("%s is of type %s, not a struct", strings.Join(segS[:i], "."), t)
+			}
+			fd, ok := t.FieldByName(seg)
+			if !ok {
+				return fmt.Errorf("no field %s in %s", seg, t)
+			}
+			if i == len(segS)-1 && !fd.IsExported() {
+				return fmt.Errorf("field %s of %s is unexported", seg, t)
+			}
+			t = fd.Type
+		}
+		return nil
+	}
+}
+
+// CheckTag checks a single log tag and returns a description of each problem found.
+// The isStruct parameter tells whether the field is a struct (or pointer to struct),
+// and resolveRef checks a ref path against the struct declaring the field.
+// It is shared by Validate and static analysis tools. Names looked up in registries at runtime,
+// such as maskers and transform functions, are not checked, since they may be registered after the check.
+func CheckTag(logTag string, isStruct bool, resolveRef func(path string) error) (msgS []string) {
+	return checkTag(logTag, isStruct, resolveRef, false)
+}
+
+// checkTag implements CheckTag. If registered is true, names are also checked against the registries.
+func checkTag(logTag string, isStruct bool, resolveRef func(path string) error, registered bool) (msgS []string) {
+	var formatS, exprS, omitS []string
+	masked := false // masked tells whether the last expression is a masking expression.
+	timed := false  // timed tells whether a time layout or zone option has been seen.
+	for i, tag := range splitTag(logTag) {
+		if tag == "" {
+			continue
+		}
+
+		// The first tag is the log name, which must not be an option.
+		if i == 0 {
+			if isOption(tag) || strings.Contains(tag, "%") {
+				msgS = append(msgS, fmt.Sprintf("log name %q looks like an option, missing leading comma?", tag))
+			}
+			continue
+		}
+
+		switch {
+		case tag == "inline":
+			// Inline is only recognized as the whole tag of a struct field.
+			if !isStruct {
+				msgS = append(msgS, "inline requires a struct field")
+			} else if logTag != ",inline" {
+				msgS = append(msgS, `inline must be the only option, as ",inline"`)
+			}
+		case strings.HasPrefix(tag, "ref:"):
+			exprS, masked = append(exprS, tag), false
+			if path := tag[4:]; path == "" {
+				msgS = append(msgS, "empty ref path")
+			} else if err := resolveRef(path); err != nil {
+				msgS = append(msgS, fmt.Sprintf("ref %q: %v", path, err))
+			}
+		case strings.HasPrefix(tag, "transform:"):
+			exprS, masked = append(exprS, tag), false
+			msgS = append(msgS, checkTransform(tag[10:], registered)...)
+		case strings.HasPrefix(tag, "time:") || strings.HasPrefix(tag, "tz:"):
+			// Time layout and zone options combine into a single expression.
+			if !timed {
+				exprS, masked, timed = append(exprS, tag), false, true
+			}
+			if strings.HasPrefix(tag, "time:") && strings.TrimSpace(tag[5:]) == "" {
+				msgS = append(msgS, "empty time layout")
+			} else if zone := strings.TrimPrefix(tag, "tz:"); zone != tag {
+				if _, err := time.LoadLocation(zone); err != nil {
+					msgS = append(msgS, fmt.Sprintf("unknown time zone %q", zone))
+				}
+			}
+		case strings.HasPrefix(tag, "duration:"):
+			exprS, masked = append(exprS, tag), false
+			if mode := tag[9:]; !durationModes[mode] {
+				msgS = append(msgS, fmt.Sprintf("unknown duration mode %q, supported are human, string, ns, ms and s", mode))
+			}
+		case tag == "omitempty" || tag == "omitzero":
+			omitS = append(omitS, tag)
+		case tag == "stringer":
+			exprS, masked = append(exprS, tag), false
+		case strings.HasPrefix(tag, "mask:"):
+			exprS, masked = append(exprS, tag), true
+			msgS = append(msgS, checkMask(strings.TrimSpace(tag[5:]), registered)...)
+		case tag == "redact":
+			exprS, masked = append(exprS, tag), true
+		case strings.HasPrefix(tag, "hash:"):
+			exprS, masked = append(exprS, tag), true
+			if algorithm := strings.TrimSpace(tag[5:]); hashFuncs[strings.TrimPrefix(algorithm, "hmac-")] == nil {
+				msgS = append(msgS, fmt.Sprintf("unknown hash algorithm %q, supported are md5, sha1, sha256 and sha512, optionally as hmac-<algorithm>", algorithm))
+			}
+		case strings.Contains(tag, "%"):
+			formatS = append(formatS, tag)
+		case isOption(tag):
+			msgS = append(msgS, fmt.Sprintf("unknown option %q", tag))
+		default:
+			msgS = append(msgS, fmt.Sprintf("unknown option %q, a format needs %% placeholders", tag))
+		}
+	}
+
+	// Only the last expression and format take effect, so more than one is a mistake.
+	if len(exprS) > 1 {
+		msgS = append(msgS, fmt.Sprintf("conflicting options %s", strings.Join(exprS, " and ")))
+	}
+	if len(omitS) > 1 {
+		msgS = append(msgS, fmt.Sprintf("conflicting options %s", strings.Join(omitS, " and ")))
+	}
+	if len(formatS) > 1 {
+		msgS = append(msgS, fmt.Sprintf("multiple formats %s", strings.Join(formatS, " and ")))
+	}
+
+	// Check the placeholder count of the format, as expected by the expressions and renderers.
+	if len(formatS) > 0 {
+		format := formatS[len(formatS)-1]
+		n := strings.Count(format, "%")
+		if masked && n != 2 {
+			msgS = append(msgS, fmt.Sprintf("format %q has %d placeholders, mask, redact and hash need 2", format, n))
+		} else if !masked && len(exprS) > 0 && n != 2 && n != 3 {
+			msgS = append(msgS, fmt.Sprintf("format %q has %d placeholders, expression options need 2 or 3", format, n))
+		} else if len(exprS) == 0 && n != 2 {
+			msgS = append(msgS, fmt.Sprintf("format %q has %d placeholders, need 2 for name and value", format, n))
+		}
+	}
+	return
+}
+
+// checkTransform checks the syntax of a transform mapping (e.g., "1,2->on|3..5->off|*->unknown") or function reference (e.g., "@status").
+// If registered is true, referenced functions must be registered.
+func checkTransform(mapping string, registered bool) (msgS []string) {
+	if strings.TrimSpace(mapping) == "" {
+		return []string{"empty transform mapping"}
+	}
+	if name := strings.TrimSpace(mapping); strings.HasPrefix(name, "@") {
+		if name = name[1:]; name == "" {
+			return []string{"empty transform function name"}
+		} else if registered && getTransform(name) == nil {
+			return []string{fmt.Sprintf("unknown transform function %q", name)}
+		}
+		return
+	}
+	if strings.TrimSpace(mapping) == "enum" {
+		return
+	}
+	_, msgS = parseTransform(mapping)
+	return
+}
+
+// checkFieldType checks the options of a log tag that depend on the dereferenced field type:
+// "transform:enum" needs an enum table registered for the type, "time:" and "tz:" need a time.Time field,
+// and "duration:" needs a time.Duration field.
+func checkFieldType(logTag string, ft reflect.Type) (msgS []string) {
+	for i, tag := range splitTag(logTag) {
+		switch {
+		case i == 0:
+			continue
+		case strings.HasPrefix(tag, "transform:") && strings.TrimSpace(tag[10:]) == "enum" && getEnum(ft) == nil:
+			msgS = append(msgS, fmt.Sprintf("no enum table registered for type %s", ft))
+		case (strings.HasPrefix(tag, "time:") || strings.HasPrefix(tag, "tz:")) && ft != timeType:
+			msgS = append(msgS, fmt.Sprintf("option %q requires a time.Time field, not %s", tag, ft))
+		case strings.HasPrefix(tag, "duration:") && ft != durationType:
+			msgS = append(msgS, fmt.Sprintf("option %q requires a time.Duration field, not %s", tag, ft))
+		}
+	}
+	return
+}
+
+// checkMask checks a masker name or keep expression (e.g., "phone" or "keep(3,4)").
+// If registered is true, masker names must be registered.
+func checkMask(expr0 string, registered bool) (msgS []string) {
+	switch {
+	case expr0 == "":
+		return []string{"empty masker"}
+	case strings.HasPrefix(expr0, "keep("):
+		if _, _, ok := parseKeep(expr0); !ok {
+			return []string{fmt.Sprintf("mask %q: keep needs two non-negative integers, as keep(3,4)", expr0)}
+		}
+	case registered && getMasker(expr0) == nil:
+		return []string{fmt.Sprintf("unknown masker %q", expr0)}
+	}
+	return
+}
+
+// isOption reports whether a tag looks like a "word:value" option.
+func isOption(tag string) bool {
+	idx := strings.IndexByte(tag, ':')
+	if idx <= 0 {
+		return false
+	}
+	for _, r := range tag[:idx] {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,35 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidationErrorAs(t *testing.T) {
+	type invalid struct {
+		A int `log:"a,ref:Missing"`
+	}
+	err := Validate(invalid{})
+	var tagErr *TagError
+	if !errors.As(err, &tagErr) {
+		t.Fatalf("errors.As(%v) did not match a *TagError", err)
+	}
+	if tagErr.Field != "A" {
+		t.Fatalf("TagError.Field = %q, want %q", tagErr.Field, "A")
+	}
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) || len(validationErr.Errs) != 1 {
+		t.Fatalf("errors.As(%v) did not match a *ValidationError with one problem", err)
+	}
+}
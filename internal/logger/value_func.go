@@ -14,70 +14,114 @@ package logger
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
+	"sync"
 )
 
-// Package-level variables for configuring logging behavior.
+// Package-level variables for configuring logging behavior, guarded by settingsMu.
 var (
+	// settingsMu guards the package-level settings against concurrent configuration and logging.
+	settingsMu sync.RWMutex
 	// arrayFunc0 is the default function for formatting array/slice values.
 	arrayFunc0 ArrayFunc
 	// mapFunc0 is the default function for formatting map values.
 	mapFunc0 MapFunc
+	// mapFormat0 is the built-in map formatting behind mapFunc0, nil if mapFunc0 was set by SetMapFunc.
+	mapFormat0 *mapFormat
 	// fieldFormat is the default format string for logging fields (e.g., "%s[%v]").
 	fieldFormat = "%s[%v]"
 	// arrayElementFormat is the default format string for array/slice elements (e.g., "{%v}").
 	arrayElementFormat = "{%v}"
 	// fieldJoinSep is the default separator for joining multiple field log strings (e.g., ",").
 	fieldJoinSep = ","
+	// diffFormat is the default format string for logging changed fields (e.g., "%s[%v=>%v]").
+	diffFormat = "%s[%v=>%v]"
+	// mapKeyComparator0 is the comparator for ordering map keys.
+	mapKeyComparator0 MapKeyComparator = naturalKeyCompare
 )
 
 // init initializes the default array and map formatting functions.
 func init() {
 	arrayFunc0 = arrayFunc00()
-	mapFunc0 = mapFunc00()
+	mapFormat0 = &mapFormat{"%s:%v", ","}
+	mapFunc0 = mapFormat0.mapFunc()
 }
 
 // SetArrayFunc sets a custom function for formatting array/slice values.
 func SetArrayFunc(arrayFunc ArrayFunc) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
 	arrayFunc0 = arrayFunc
 }
 
 // SetMapFunc sets a custom function for formatting map values.
 func SetMapFunc(mapFunc MapFunc) {
-	mapFunc0 = mapFunc
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	mapFunc0, mapFormat0 = mapFunc, nil
 }
 
 // SetFieldFormat sets a custom format string for logging fields.
 func SetFieldFormat(format string) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
 	fieldFormat = format
 }
 
 // SetArrayElementFormat sets a custom format string for array/slice elements.
 func SetArrayElementFormat(format string) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
 	arrayElementFormat = format
 }
 
 // SetFieldJoinSep sets a custom separator for joining multiple field log strings.
 func SetFieldJoinSep(sep string) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
 	fieldJoinSep = sep
 }
 
+// SetDiffFormat sets a custom format string for logging changed fields.
+func SetDiffFormat(format string) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	diffFormat = format
+}
+
+// SetMapKeyComparator sets a custom comparator for ordering map keys, or restores the natural ordering if nil.
+func SetMapKeyComparator(cmp MapKeyComparator) {
+	settingsMu.Lock()
+	defer settingsMu.Unlock()
+	if cmp == nil {
+		cmp = naturalKeyCompare
+	}
+	mapKeyComparator0 = cmp
+}
+
+// getMapKeyComparator retrieves the comparator for ordering map keys.
+func getMapKeyComparator() MapKeyComparator {
+	settingsMu.RLock()
+	defer settingsMu.RUnlock()
+	return mapKeyComparator0
+}
+
 // ArrayFunc defines a function type for formatting array/slice values into a single value.
 type ArrayFunc func(v reflect.Value) (vv any)
 
 // MapFunc defines a function type for formatting map values into a single value.
 type MapFunc func(v reflect.Value) (vv any)
 
+// MapKeyComparator defines a function type for ordering map keys, returning a negative number if a sorts before b,
+// zero if they are equal, and a positive number otherwise.
+type MapKeyComparator func(a, b reflect.Value) int
+
 // arrayFunc00 returns the default ArrayFunc that formats array/slice elements with a standard format and separator.
 func arrayFunc00() ArrayFunc {
 	return arrayFunc("%v", ",")
 }
 
-// mapFunc00 returns the default MapFunc that formats map key-value pairs with a standard format and separator.
-func mapFunc00() MapFunc {
-	return mapFunc("%s:%v", ",")
-}
-
 // arrayFunc creates an ArrayFunc that formats array/slice elements using the provided format and separator.
 // Each element is formatted according to the format string and joined with the separator.
 func arrayFunc(format, sep string) ArrayFunc {
@@ -86,9 +130,13 @@ func arrayFunc(format, sep string) ArrayFunc {
 		// Iterate over the array/slice elements.
 		for i := 0; i < v.Len(); i++ {
 			iv := v.Index(i)
-			// Only include elements that can be interfaced.
+			// Only include elements that can be interfaced, rendering built-in types such as time.Time.
 			if iv.CanInterface() {
-				arrS = append(arrS, fmt.Sprintf(format, iv.Interface()))
+				var value any = iv.Interface()
+				if bv, ok := scalarValue(iv); ok {
+					value = bv
+				}
+				arrS = append(arrS, fmt.Sprintf(format, value))
 			}
 		}
 		// Join the formatted elements with the separator.
@@ -96,22 +144,135 @@ func arrayFunc(format, sep string) ArrayFunc {
 	}
 }
 
-// mapFunc creates a MapFunc that formats map key-value pairs using the provided format and separator.
-// Each key-value pair is formatted according to the format string and joined with the separator.
+// mapFunc creates a MapFunc that formats map key-value pairs using the provided format and separator,
+// rendering the values with the package-level settings.
 func mapFunc(format, sep string) MapFunc {
+	return (&mapFormat{format, sep}).mapFunc()
+}
+
+// mapFormat is the built-in map formatting, which formats map key-value pairs using a format and separator.
+// A BracketRenderer whose MapFunc is left to the default renders the map values with its own options.
+type mapFormat struct {
+	format string // format is the format string of a key-value pair (e.g., "%s:%v").
+	sep    string // sep is the separator for joining the key-value pairs (e.g., ",").
+}
+
+// mapFunc returns a MapFunc applying the map formatting with the package-level settings.
+func (f *mapFormat) mapFunc() MapFunc {
 	return func(v reflect.Value) (vv any) {
-		var arrS []string
-		// Iterate over the map's key-value pairs.
-		mr := v.MapRange()
-		for mr.Next() {
-			mk := mr.Key()
-			mv := mr.Value()
-			// Only include pairs where both key and value can be interfaced.
-			if mk.CanInterface() && mv.CanInterface() {
-				arrS = append(arrS, fmt.Sprintf(format, mk, mv.Interface()))
-			}
+		return f.render(BracketRenderer{}.resolve(), v)
+	}
+}
+
+// render formats the key-value pairs of a map, rendering the values with the given resolved renderer.
+// Each key-value pair is formatted according to the format string and joined with the separator,
+// in the order of the map key comparator. Values whose key matches the redaction policy are redacted.
+func (f *mapFormat) render(r BracketRenderer, v reflect.Value) any {
+	var arrS []string
+	// Iterate over the map's key-value pairs in sorted key order.
+	for _, e := range sortedMapEntries(v) {
+		// Only include pairs where both key and value can be interfaced.
+		if !e.key.CanInterface() || !e.value.CanInterface() {
+			continue
 		}
-		// Join the formatted pairs with the separator.
-		return strings.Join(arrS, sep)
+		// Redact values whose key matches the redaction policy.
+		key := fmt.Sprintf("%v", e.key)
+		var value any = Redacted
+		if !shouldRedact(key) {
+			value = mapValue(r, e.value)
+		}
+		arrS = append(arrS, fmt.Sprintf(f.format, key, value))
+	}
+	// Join the formatted pairs with the separator.
+	return strings.Join(arrS, f.sep)
+}
+
+// mapValue converts a map value to the value that is logged by a resolved renderer, applying the log tag rules
+// to its dynamic value: structs are rendered with their fields in the array element format (e.g., "{Name[Kellen]}"),
+// arrays/slices of structs element by element, and other values as field values, recursing into maps.
+func mapValue(r BracketRenderer, mv reflect.Value) any {
+	sv := rv(dynamicValue(mv))
+	if isNil(sv) {
+		return nil
+	}
+	switch {
+	case !isNested(sv.Type()):
+		return r.value(sv)
+	case isStruct0(sv):
+		return fmt.Sprintf(r.ArrayElementFormat, r.Render(getSupportedFields(sv)))
+	}
+	return r.Render(getSupportedFields(sv))
+}
+
+// mapEntry is a key-value pair of a map.
+type mapEntry struct {
+	key, value reflect.Value
+}
+
+// sortedMapEntries returns the key-value pairs of a map sorted by the map key comparator.
+// The pairs are collected while iterating the map, since keys such as NaN cannot be looked up again.
+// Keys that compare equal, such as several NaN keys, are ordered by the "%v" form of their values.
+func sortedMapEntries(v reflect.Value) []mapEntry {
+	entries := make([]mapEntry, 0, v.Len())
+	for iter := v.MapRange(); iter.Next(); {
+		entries = append(entries, mapEntry{iter.Key(), iter.Value()})
+	}
+	cmp := getMapKeyComparator()
+	sort.Slice(entries, func(i, j int) bool {
+		if c := cmp(entries[i].key, entries[j].key); c != 0 {
+			return c < 0
+		}
+		return fmt.Sprintf("%v", entries[i].value) < fmt.Sprintf("%v", entries[j].value)
+	})
+	return entries
+}
+
+// naturalKeyCompare orders map keys naturally: numbers numerically with NaN first, strings lexically, and other keys,
+// or keys of different kinds, by their type and "%v" form. Interface keys are compared by their dynamic values.
+func naturalKeyCompare(a, b reflect.Value) int {
+	a, b = dynamicValue(a), dynamicValue(b)
+	switch {
+	case a.CanInt() && b.CanInt():
+		return compare(a.Int(), b.Int())
+	case a.CanUint() && b.CanUint():
+		return compare(a.Uint(), b.Uint())
+	case a.Kind() == reflect.String && b.Kind() == reflect.String:
+		return strings.Compare(a.String(), b.String())
+	}
+	if af, ok := toFloat(a); ok {
+		if bf, ok := toFloat(b); ok && af != bf {
+			return compare(af, bf)
+		}
+	}
+	if c := strings.Compare(a.Type().String(), b.Type().String()); c != 0 {
+		return c
+	}
+	return strings.Compare(fmt.Sprintf("%v", a.Interface()), fmt.Sprintf("%v", b.Interface()))
+}
+
+// compare returns -1, 0 or 1 as a is less than, equal to or greater than b.
+// A NaN is less than any other number and equal to another NaN.
+func compare[T int64 | uint64 | float64](a, b T) int {
+	// A NaN is the only value not equal to itself.
+	aNaN, bNaN := a != a, b != b
+	switch {
+	case aNaN || bNaN:
+		return compareBool(!aNaN, !bNaN)
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// compareBool returns -1, 0 or 1 as a is less than, equal to or greater than b, with false before true.
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
 	}
+	return -1
 }
@@ -0,0 +1,61 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestMapFuncNaNKeys(t *testing.T) {
+	type scores struct {
+		M map[float64]string `log:"m"`
+	}
+	s := scores{map[float64]string{math.NaN(): "b", 1: "c", math.Inf(-1): "d"}}
+	s.M[math.NaN()] = "a"
+
+	fs := GetFields(s)
+	if got, want := fs.Log(), "m[NaN:a,NaN:b,-Inf:d,1:c]"; got != want {
+		t.Fatalf("GetFields().Log() = %q, want %q", got, want)
+	}
+	if got, want := fs.JSON(), `{"m":{"NaN":"a","NaN#2":"b","-Inf":"d","1":"c"}}`; got != want {
+		t.Fatalf("GetFields().JSON() = %s, want %s", got, want)
+	}
+}
+
+func TestMapJSONDuplicateKeys(t *testing.T) {
+	type extra struct {
+		M map[any]int `log:"m"`
+	}
+	got := GetFields(extra{map[any]int{1: 1, "1": 2, "1#2": 3}}).JSON()
+	var m map[string]map[string]int
+	if err := json.Unmarshal([]byte(got), &m); err != nil {
+		t.Fatalf("GetFields().JSON() = %s is not valid JSON: %v", got, err)
+	}
+	if len(m["m"]) != 3 {
+		t.Fatalf("GetFields().JSON() = %s, want 3 distinct members", got)
+	}
+}
+
+func TestMapFuncRendererOptions(t *testing.T) {
+	type item struct {
+		A int `log:"a"`
+	}
+	type order struct {
+		Items map[string]item `log:"items"`
+	}
+	r := BracketRenderer{FieldFormat: "%s=<%v>", ArrayElementFormat: "(%v)"}
+	if got, want := GetFields(order{map[string]item{"k": {1}}}).Render(r), "items=<k:(a=<1>)>"; got != want {
+		t.Fatalf("GetFields().Render() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// JSON is a JSON document stored in a column of the JSON type of the database dialect:
+// JSON for MySQL and SQLite, JSONB for PostgreSQL, and text for the others.
+// An empty document is stored as NULL, since JSON columns accept neither empty strings nor defaults on all dialects.
+type JSON string
+
+// GormDataType returns the general data type of the column.
+func (JSON) GormDataType() string {
+	return "json"
+}
+
+// GormDBDataType returns the column type for the dialect of the database.
+func (JSON) GormDBDataType(db *gorm.DB, _ *schema.Field) string {
+	switch db.Dialector.Name() {
+	case "mysql", "sqlite":
+		return "JSON"
+	case "postgres":
+		return "JSONB"
+	case "sqlserver":
+		return "NVARCHAR(MAX)"
+	}
+	return "TEXT"
+}
+
+// Value implements driver.Valuer, storing an empty document as NULL.
+func (j JSON) Value() (driver.Value, error) {
+	if j == "" {
+		return nil, nil
+	}
+	return string(j), nil
+}
+
+// Scan implements sql.Scanner, reading NULL as an empty document.
+func (j *JSON) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*j = ""
+	case []byte:
+		*j = JSON(v)
+	case string:
+		*j = JSON(v)
+	default:
+		return fmt.Errorf("unilog: cannot scan %T into JSON", src)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+// namedDialector is a gorm.Dialector reporting the given name, for checking column types per dialect.
+type namedDialector struct {
+	gorm.Dialector
+	name string
+}
+
+func (d namedDialector) Name() string {
+	return d.name
+}
+
+func TestJSONGormDBDataType(t *testing.T) {
+	tests := map[string]string{"mysql": "JSON", "sqlite": "JSON", "postgres": "JSONB", "sqlserver": "NVARCHAR(MAX)", "clickhouse": "TEXT"}
+	for name, want := range tests {
+		db := &gorm.DB{Config: &gorm.Config{Dialector: namedDialector{name: name}}}
+		if got := JSON("").GormDBDataType(db, nil); got != want {
+			t.Errorf("GormDBDataType(%s) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestJSONValueScan(t *testing.T) {
+	if v, err := JSON("").Value(); v != nil || err != nil {
+		t.Fatalf("Value() of an empty document = %v, %v, want nil, nil", v, err)
+	}
+	if v, err := JSON(`{"a":1}`).Value(); v != `{"a":1}` || err != nil {
+		t.Fatalf("Value() = %v, %v, want the document", v, err)
+	}
+
+	var j JSON = "stale"
+	for _, src := range []any{nil, []byte(`{"a":1}`), `{"b":2}`} {
+		if err := j.Scan(src); err != nil {
+			t.Fatalf("Scan(%v) = %v", src, err)
+		}
+	}
+	if j != `{"b":2}` {
+		t.Fatalf("Scan() = %q, want %q", j, `{"b":2}`)
+	}
+	if err := j.Scan(nil); err != nil || j != "" {
+		t.Fatalf("Scan(nil) = %q, %v, want an empty document", j, err)
+	}
+	if err := j.Scan(1); err == nil {
+		t.Fatal("Scan(1) returned no error")
+	}
+}
@@ -0,0 +1,288 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-the-way/unilog/internal/models"
+)
+
+// OverflowPolicy defines what an AsyncWriter does with a new log entry when its queue is full.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock waits until the queue has room for the new log entry.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropNewest drops the new log entry.
+	OverflowDropNewest
+	// OverflowDropOldest drops the oldest queued log entry to make room for the new one.
+	OverflowDropOldest
+)
+
+var (
+	// ErrAsyncClosed is returned when adding a log entry to a closed AsyncWriter.
+	ErrAsyncClosed = errors.New("unilog: the async writer is closed")
+	// ErrAsyncQueueFull is reported when a log entry is dropped because the queue is full.
+	ErrAsyncQueueFull = errors.New("unilog: the async queue is full")
+)
+
+// AsyncConfig configures an AsyncWriter. Zero values fall back to the defaults noted on each field.
+type AsyncConfig struct {
+	QueueSize     int            // QueueSize is the capacity of the queue, defaults to 1024.
+	Workers       int            // Workers is the number of background workers, defaults to 1.
+	BatchSize     int            // BatchSize is the maximum number of log entries per insert, defaults to 100.
+	FlushInterval time.Duration  // FlushInterval is the maximum time a log entry waits in a batch, defaults to 1s.
+	Overflow      OverflowPolicy // Overflow is the policy applied when the queue is full, defaults to OverflowBlock.
+	// ErrorFunc is called with the affected requests when a batch fails to be added or a log entry is dropped,
+	// by either overflow policy. If nil, the error is passed to the ErrorHandler set through SetErrorHandler
+	// for each request, except for a new log entry dropped by OverflowDropNewest: Add returns ErrAsyncQueueFull
+	// for it, which Callback already passes to the ErrorHandler.
+	ErrorFunc func(err error, reqs []AddReq)
+}
+
+// asyncItem is a queued log entry along with the request it was created from.
+type asyncItem struct {
+	req   AddReq
+	entry *models.Log
+}
+
+// AsyncWriter adds log entries in the background, batching them into multi-row inserts.
+type AsyncWriter struct {
+	s       *Service // s is the Service the log entries are added with.
+	cfg     AsyncConfig
+	mu      sync.RWMutex // mu guards closed against concurrent sends on the queue.
+	closed  bool
+	queue   chan asyncItem
+	flushCS []chan chan struct{} // flushCS holds a flush channel per worker.
+	wg      sync.WaitGroup
+	once    sync.Once     // once guards closing against being closed twice.
+	closing chan struct{} // closing is closed when Close is called, waking up senders blocked on a full queue.
+	stopped chan struct{} // stopped is closed when all workers have stopped.
+}
+
+// newAsyncWriter creates an AsyncWriter, applying defaults to the configuration, and starts its workers.
+func newAsyncWriter(s *Service, cfg AsyncConfig) *AsyncWriter {
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = 1024
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = 1
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 100
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = time.Second
+	}
+	w := &AsyncWriter{
+		s:       s,
+		cfg:     cfg,
+		queue:   make(chan asyncItem, cfg.QueueSize),
+		closing: make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	for i := 0; i < cfg.Workers; i++ {
+		flushC := make(chan chan struct{})
+		w.flushCS = append(w.flushCS, flushC)
+		w.wg.Add(1)
+		go w.work(flushC)
+	}
+	go func() {
+		w.wg.Wait()
+		close(w.stopped)
+	}()
+	return w
+}
+
+// Add enqueues a log entry, applying the overflow policy when the queue is full.
+func (w *AsyncWriter) Add(req AddReq) (err error) {
+	return w.AddCtx(context.Background(), req)
+}
+
+// AddCtx is like Add, but stops waiting for room in the queue when the context is done.
+// The context only applies to enqueueing, since the log entry is added after the caller has returned.
+// A call waiting for room in the queue returns ErrAsyncClosed if the AsyncWriter is closed meanwhile.
+func (w *AsyncWriter) AddCtx(ctx context.Context, req AddReq) (err error) {
+	item := asyncItem{req, req.transform()}
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return ErrAsyncClosed
+	}
+	switch w.cfg.Overflow {
+	default:
+		select {
+		case w.queue <- item:
+		case <-w.closing:
+			return ErrAsyncClosed
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	case OverflowDropNewest:
+		select {
+		case w.queue <- item:
+		default:
+			if w.cfg.ErrorFunc != nil {
+				w.cfg.ErrorFunc(ErrAsyncQueueFull, []AddReq{req})
+			}
+			return ErrAsyncQueueFull
+		}
+	case OverflowDropOldest:
+		for {
+			select {
+			case w.queue <- item:
+				return
+			default:
+			}
+			select {
+			case oldest := <-w.queue:
+				w.reportError(ErrAsyncQueueFull, []asyncItem{oldest})
+			default:
+			}
+		}
+	}
+	return
+}
+
+// Flush waits until all log entries enqueued before the call have been added, or the context is done.
+func (w *AsyncWriter) Flush(ctx context.Context) (err error) {
+	w.mu.RLock()
+	closed := w.closed
+	w.mu.RUnlock()
+	if closed {
+		return ErrAsyncClosed
+	}
+	done := make(chan struct{}, len(w.flushCS))
+	for _, flushC := range w.flushCS {
+		select {
+		case flushC <- done:
+		case <-w.stopped:
+			// The workers stopped after Close, having added all queued log entries.
+			return ErrAsyncClosed
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	for range w.flushCS {
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return
+}
+
+// Close stops accepting log entries and waits until the queued ones have been added, or the context is done.
+// If the AsyncWriter is the one used by its Service, the Service returns to adding synchronously.
+func (w *AsyncWriter) Close(ctx context.Context) (err error) {
+	// Wake up senders blocked on a full queue first, since they hold the read lock while waiting.
+	w.once.Do(func() { close(w.closing) })
+	w.mu.Lock()
+	if !w.closed {
+		w.closed = true
+		close(w.queue)
+		w.s.stopAsync(w)
+	}
+	w.mu.Unlock()
+
+	select {
+	case <-w.stopped:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	return
+}
+
+// work consumes the queue, adding log entries in batches when a batch is full, the flush interval elapses,
+// a flush is requested, or the queue is closed.
+func (w *AsyncWriter) work(flushC chan chan struct{}) {
+	defer w.wg.Done()
+	ticker := time.NewTicker(w.cfg.FlushInterval)
+	defer ticker.Stop()
+	batch := make([]asyncItem, 0, w.cfg.BatchSize)
+	add := func() {
+		w.write(batch)
+		batch = batch[:0]
+	}
+	for {
+		select {
+		case item, ok := <-w.queue:
+			if !ok {
+				add()
+				return
+			}
+			if batch = append(batch, item); len(batch) >= w.cfg.BatchSize {
+				add()
+			}
+		case <-ticker.C:
+			add()
+		case done := <-flushC:
+			// Drain the queue without waiting for new log entries.
+		drain:
+			for {
+				select {
+				case item, ok := <-w.queue:
+					if !ok {
+						break drain
+					}
+					if batch = append(batch, item); len(batch) >= w.cfg.BatchSize {
+						add()
+					}
+				default:
+					break drain
+				}
+			}
+			add()
+			done <- struct{}{}
+		}
+	}
+}
+
+// write adds a batch of log entries and runs their callbacks, reporting the requests that failed.
+func (w *AsyncWriter) write(batch []asyncItem) {
+	if len(batch) == 0 {
+		return
+	}
+	reqs := make([]AddReq, len(batch))
+	entries := make([]*models.Log, len(batch))
+	for i, item := range batch {
+		reqs[i], entries[i] = item.req, item.entry
+	}
+	var batchErr *BatchError
+	if err := w.s.addBatch(context.Background(), reqs, entries); errors.As(err, &batchErr) {
+		for i, err0 := range batchErr.Errs {
+			if err0 != nil {
+				w.reportError(err0, batch[i:i+1])
+			}
+		}
+	}
+}
+
+// reportError passes an error and the affected requests to the configured ErrorFunc, or to the ErrorHandler.
+func (w *AsyncWriter) reportError(err error, items []asyncItem) {
+	if w.cfg.ErrorFunc == nil {
+		for _, item := range items {
+			w.s.HandleError(err, item.req)
+		}
+		return
+	}
+	reqs := make([]AddReq, len(items))
+	for i, item := range items {
+		reqs[i] = item.req
+	}
+	w.cfg.ErrorFunc(err, reqs)
+}
@@ -0,0 +1,195 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-the-way/unilog/internal/models"
+)
+
+// blockingStore is a Store whose AddBatch blocks until release is closed.
+type blockingStore struct {
+	Store
+	entered chan struct{} // entered receives a value each time AddBatch is called.
+	release chan struct{}
+}
+
+func (s *blockingStore) AddBatch(ctx context.Context, entries []*models.Log) (err error) {
+	s.entered <- struct{}{}
+	<-s.release
+	return s.Store.AddBatch(ctx, entries)
+}
+
+// batchStore is a Store sending the size of each batch added to batches.
+type batchStore struct {
+	Store
+	batches chan int
+}
+
+func (s *batchStore) AddBatch(ctx context.Context, entries []*models.Log) (err error) {
+	s.batches <- len(entries)
+	return s.Store.AddBatch(ctx, entries)
+}
+
+// startBlocked starts an AsyncWriter with a queue of 1 on a blockingStore, blocks its worker on a first
+// log entry and fills the queue with a second, so that the queue is full.
+func startBlocked(t *testing.T, cfg AsyncConfig) (*AsyncWriter, *blockingStore) {
+	st := &blockingStore{Store: NewMemoryStore(), entered: make(chan struct{}, 8), release: make(chan struct{})}
+	cfg.QueueSize, cfg.BatchSize = 1, 1
+	w := NewService(st).StartAsync(cfg)
+	if err := w.Add(AddReq{Content: "1"}); err != nil {
+		t.Fatal(err)
+	}
+	<-st.entered
+	if err := w.Add(AddReq{Content: "2"}); err != nil {
+		t.Fatal(err)
+	}
+	return w, st
+}
+
+// closeAndContents releases the blockingStore, closes the AsyncWriter and returns the contents of the added log entries.
+func closeAndContents(t *testing.T, w *AsyncWriter, st *blockingStore) (contents []string) {
+	close(st.release)
+	if err := w.Close(context.Background()); err != nil {
+		t.Fatalf("Close() = %v", err)
+	}
+	_, list, _ := st.GetPage(context.Background(), GetPageReq{OrderBy: "id"})
+	for _, entry := range list {
+		contents = append(contents, entry.Content)
+	}
+	return
+}
+
+// droppedFunc returns an ErrorFunc recording the contents of the dropped requests.
+func droppedFunc(dropped *[]string) func(err error, reqs []AddReq) {
+	return func(err error, reqs []AddReq) {
+		if errors.Is(err, ErrAsyncQueueFull) {
+			for _, req := range reqs {
+				*dropped = append(*dropped, req.Content)
+			}
+		}
+	}
+}
+
+func TestAsyncWriterOverflowBlock(t *testing.T) {
+	w, st := startBlocked(t, AsyncConfig{Overflow: OverflowBlock})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := w.AddCtx(ctx, AddReq{Content: "3"}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("AddCtx() on a full queue = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if got := closeAndContents(t, w, st); len(got) != 2 || got[0] != "1" || got[1] != "2" {
+		t.Fatalf("added %v, want [1 2]", got)
+	}
+}
+
+func TestAsyncWriterOverflowDropNewest(t *testing.T) {
+	var dropped []string
+	w, st := startBlocked(t, AsyncConfig{Overflow: OverflowDropNewest, ErrorFunc: droppedFunc(&dropped)})
+	if err := w.Add(AddReq{Content: "3"}); !errors.Is(err, ErrAsyncQueueFull) {
+		t.Fatalf("Add() on a full queue = %v, want %v", err, ErrAsyncQueueFull)
+	}
+	if got := closeAndContents(t, w, st); len(got) != 2 || got[0] != "1" || got[1] != "2" {
+		t.Fatalf("added %v, want [1 2]", got)
+	}
+	if len(dropped) != 1 || dropped[0] != "3" {
+		t.Fatalf("dropped %v, want [3]", dropped)
+	}
+}
+
+func TestAsyncWriterOverflowDropOldest(t *testing.T) {
+	var dropped []string
+	w, st := startBlocked(t, AsyncConfig{Overflow: OverflowDropOldest, ErrorFunc: droppedFunc(&dropped)})
+	if err := w.Add(AddReq{Content: "3"}); err != nil {
+		t.Fatalf("Add() on a full queue = %v", err)
+	}
+	if got := closeAndContents(t, w, st); len(got) != 2 || got[0] != "1" || got[1] != "3" {
+		t.Fatalf("added %v, want [1 3]", got)
+	}
+	if len(dropped) != 1 || dropped[0] != "2" {
+		t.Fatalf("dropped %v, want [2]", dropped)
+	}
+}
+
+func TestAsyncWriterFlushTimeout(t *testing.T) {
+	w, st := startBlocked(t, AsyncConfig{})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := w.Flush(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Flush() with a blocked worker = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if got := closeAndContents(t, w, st); len(got) != 2 {
+		t.Fatalf("added %v, want [1 2]", got)
+	}
+}
+
+func TestAsyncWriterFlushInterval(t *testing.T) {
+	st := &batchStore{Store: NewMemoryStore(), batches: make(chan int, 8)}
+	w := NewService(st).StartAsync(AsyncConfig{BatchSize: 100, FlushInterval: 100 * time.Millisecond})
+	defer func() { _ = w.Close(context.Background()) }()
+	for _, content := range []string{"1", "2", "3"} {
+		if err := w.Add(AddReq{Content: content}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The entries are added in a single batch once the flush interval elapses, without a Flush.
+	select {
+	case n := <-st.batches:
+		if n != 3 {
+			t.Fatalf("first batch has %d log entries, want 3", n)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no batch added within the flush interval")
+	}
+}
+
+func TestAsyncWriterCloseWakesBlockedSenders(t *testing.T) {
+	st := &blockingStore{Store: NewMemoryStore(), entered: make(chan struct{}, 8), release: make(chan struct{})}
+	w := NewService(st).StartAsync(AsyncConfig{QueueSize: 1, BatchSize: 1})
+
+	// Block the worker on the first entry, fill the queue with the second, and block a sender on the third.
+	if err := w.Add(AddReq{Content: "1"}); err != nil {
+		t.Fatal(err)
+	}
+	<-st.entered
+	if err := w.Add(AddReq{Content: "2"}); err != nil {
+		t.Fatal(err)
+	}
+	errC := make(chan error, 1)
+	go func() { errC <- w.Add(AddReq{Content: "3"}) }()
+
+	// Close must honor its context although the sender holds the read lock on a full queue.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := w.Close(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Close() = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if err := <-errC; !errors.Is(err, ErrAsyncClosed) {
+		t.Fatalf("blocked Add() = %v, want %v", err, ErrAsyncClosed)
+	}
+
+	close(st.release)
+	if err := w.Close(context.Background()); err != nil {
+		t.Fatalf("Close() = %v", err)
+	}
+	if err := w.Flush(context.Background()); !errors.Is(err, ErrAsyncClosed) {
+		t.Fatalf("Flush() after Close() = %v, want %v", err, ErrAsyncClosed)
+	}
+	if total, _, _ := st.GetPage(context.Background(), GetPageReq{}); total != 2 {
+		t.Fatalf("added %d log entries, want 2", total)
+	}
+}
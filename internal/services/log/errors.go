@@ -0,0 +1,38 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNilTx is returned when adding a log entry inside a nil transaction.
+var ErrNilTx = errors.New("unilog: the transaction is nil")
+
+// BatchError reports the log entries of a batch that failed to be added.
+type BatchError struct {
+	Errs   []error // Errs holds an error per request of the batch, nil for the ones that were added.
+	Failed int     // Failed is the number of requests that failed.
+}
+
+func (e *BatchError) Error() string {
+	for _, err := range e.Errs {
+		if err != nil {
+			return fmt.Sprintf("unilog: %d of %d log entries failed to add: %v", e.Failed, len(e.Errs), err)
+		}
+	}
+	return fmt.Sprintf("unilog: %d of %d log entries failed to add", e.Failed, len(e.Errs))
+}
+
+// ErrorHandler handles an error from adding a log entry that cannot be returned to the caller.
+type ErrorHandler func(err error, req AddReq)
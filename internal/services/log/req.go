@@ -11,6 +11,8 @@
 
 package log
 
+import "github.com/go-the-way/unilog/internal/logger"
+
 type (
 	GetPageReq struct {
 		Page  int `form:"page"`
@@ -38,16 +40,18 @@ type (
 	}
 	GetReq IdReq
 	AddReq struct {
-		UserId   uint   `json:"user_id"`   // 用户Id
-		UserName string `json:"user_name"` // 用户名称
-		ClientIP string `json:"client_ip"` // 客户端IP
-		Type1    string `json:"type1"`     // 类型1
-		Type2    string `json:"type2"`     // 类型2
-		Type3    string `json:"type3"`     // 类型3
-		Type4    string `json:"type4"`     // 类型4
-		Type5    string `json:"type5"`     // 类型5
-		Content  string `json:"content"`   // 日志内容
-		Callback func(req AddReq)
+		UserId      uint              `json:"user_id"`      // 用户Id
+		UserName    string            `json:"user_name"`    // 用户名称
+		ClientIP    string            `json:"client_ip"`    // 客户端IP
+		Type1       string            `json:"type1"`        // 类型1
+		Type2       string            `json:"type2"`        // 类型2
+		Type3       string            `json:"type3"`        // 类型3
+		Type4       string            `json:"type4"`        // 类型4
+		Type5       string            `json:"type5"`        // 类型5
+		Content     string            `json:"content"`      // 日志内容
+		ContentJSON string            `json:"content_json"` // 结构化日志内容
+		Fields      logger.FieldSlice `json:"-"`            // 日志字段
+		Callback    func(req AddReq)
 	}
 	UpdateReq struct {
 		IdReq    `validate:"valid(T)"`
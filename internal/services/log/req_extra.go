@@ -18,31 +18,34 @@ import (
 
 func (req *AddReq) transform() *models.Log {
 	return &models.Log{
-		UserId:     req.UserId,
-		UserName:   req.UserName,
-		ClientIP:   req.ClientIP,
-		Type1:      req.Type1,
-		Type2:      req.Type2,
-		Type3:      req.Type3,
-		Type4:      req.Type4,
-		Type5:      req.Type5,
-		Content:    req.Content,
-		CreateTime: pkg.TimeNowStr(),
-		UpdateTime: pkg.TimeNowStr(),
+		UserId:      req.UserId,
+		UserName:    req.UserName,
+		ClientIP:    req.ClientIP,
+		Type1:       req.Type1,
+		Type2:       req.Type2,
+		Type3:       req.Type3,
+		Type4:       req.Type4,
+		Type5:       req.Type5,
+		Content:     req.Content,
+		ContentJSON: models.JSON(req.ContentJSON),
+		CreateTime:  pkg.TimeNowStr(),
+		UpdateTime:  pkg.TimeNowStr(),
 	}
 }
 
-func (req *UpdateReq) transform() map[string]any {
-	return map[string]any{
-		"user_id":     req.UserId,
-		"user_name":   req.UserName,
-		"client_ip":   req.ClientIP,
-		"type1":       req.Type1,
-		"type2":       req.Type2,
-		"type3":       req.Type3,
-		"type4":       req.Type4,
-		"type5":       req.Type5,
-		"content":     req.Content,
-		"update_time": pkg.TimeNowStr(),
+func (req *UpdateReq) transform() *models.Log {
+	return &models.Log{
+		Id:          req.Id,
+		UserId:      req.UserId,
+		UserName:    req.UserName,
+		ClientIP:    req.ClientIP,
+		Type1:       req.Type1,
+		Type2:       req.Type2,
+		Type3:       req.Type3,
+		Type4:       req.Type4,
+		Type5:       req.Type5,
+		Content:     req.Content,
+		ContentJSON: models.JSON(req.ContentJSON),
+		UpdateTime:  pkg.TimeNowStr(),
 	}
 }
@@ -12,61 +12,199 @@
 package log
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/go-the-way/unilog/internal/db"
 	"github.com/go-the-way/unilog/internal/models"
-	"github.com/go-the-way/unilog/internal/pkg"
 	"github.com/go-the-way/unilog/internal/services/base"
+	"gorm.io/gorm"
 )
 
-type service struct{}
-
-func (s *service) GetPage(req GetPageReq) (resp GetPageResp, err error) {
-	q := db.GetDB().Model(new(models.Log))
-	pkg.IfGt0Func(req.Id, func() { q.Where("id=?", req.Id) })
-	pkg.IfGt0Func(req.UserId, func() { q.Where("user_id=?", req.UserId) })
-	pkg.IfNotEmptyFunc(req.UserName, func() { q.Where("user_name like concat('%',?,'%')", req.UserName) })
-	pkg.IfNotEmptyFunc(req.ClientIP, func() { q.Where("client_ip like concat('%',?,'%')", req.ClientIP) })
-	pkg.IfNotEmptyFunc(req.Type1, func() { q.Where("type1=?", req.Type1) })
-	pkg.IfNotEmptyFunc(req.Type2, func() { q.Where("type2=?", req.Type2) })
-	pkg.IfNotEmptyFunc(req.Type3, func() { q.Where("type3=?", req.Type3) })
-	pkg.IfNotEmptyFunc(req.Type4, func() { q.Where("type4=?", req.Type4) })
-	pkg.IfNotEmptyFunc(req.Type5, func() { q.Where("type5=?", req.Type5) })
-	pkg.IfNotEmptyFunc(req.Content, func() { q.Where("content like concat('%',?,'%')", req.Content) })
-	pkg.IfNotEmptyFunc(req.CreateTime1, func() { q.Where("create_time>=concat(?,' 00:00:00')", req.CreateTime1) })
-	pkg.IfNotEmptyFunc(req.CreateTime2, func() { q.Where("create_time<=concat(?,' 23:59:59')", req.CreateTime2) })
-	pkg.IfNotEmptyFunc(req.UpdateTime1, func() { q.Where("update_time>=concat(?,' 00:00:00')", req.UpdateTime1) })
-	pkg.IfNotEmptyFunc(req.UpdateTime2, func() { q.Where("update_time<=concat(?,' 23:59:59')", req.UpdateTime2) })
-	if req.OrderBy != "" {
-		q.Order(req.OrderBy)
+// Ensure Service implements the svc interface.
+var _ svc = (*Service)(nil)
+
+// Service is a log service with its own Store, asynchronous writer, and error handler.
+type Service struct {
+	mu           sync.RWMutex // mu guards the fields below against concurrent configuration and use.
+	store        Store
+	asyncWriter  *AsyncWriter
+	errorHandler ErrorHandler
+}
+
+// NewService creates a Service using the given Store, or the gorm database set through SetDB if nil.
+func NewService(st Store) *Service {
+	if st == nil {
+		st = NewGormStore(nil)
+	}
+	return &Service{store: st}
+}
+
+// SetStore sets the Store used by the Service.
+func (s *Service) SetStore(st Store) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.store = st
+}
+
+// GetStore retrieves the Store used by the Service.
+func (s *Service) GetStore() Store {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.store
+}
+
+// SetErrorHandler sets the ErrorHandler invoked when adding a log entry fails without a caller to return the error to.
+func (s *Service) SetErrorHandler(handler ErrorHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errorHandler = handler
+}
+
+// HandleError passes a non-nil error and the request it occurred for to the ErrorHandler, if any.
+func (s *Service) HandleError(err error, req AddReq) {
+	s.mu.RLock()
+	handler := s.errorHandler
+	s.mu.RUnlock()
+	if err != nil && handler != nil {
+		handler(err, req)
+	}
+}
+
+// StartAsync starts an AsyncWriter with the given configuration and makes Add enqueue log entries to it.
+// Call Close on the returned AsyncWriter to flush the queued log entries and return to synchronous adding.
+func (s *Service) StartAsync(cfg AsyncConfig) *AsyncWriter {
+	w := newAsyncWriter(s, cfg)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.asyncWriter = w
+	return w
+}
+
+// getAsync retrieves the AsyncWriter used by Add, or nil when adding synchronously.
+func (s *Service) getAsync() *AsyncWriter {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.asyncWriter
+}
+
+// stopAsync returns to synchronous adding if the given AsyncWriter is the one used by Add.
+func (s *Service) stopAsync(w *AsyncWriter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.asyncWriter == w {
+		s.asyncWriter = nil
 	}
-	resp.List = make([]models.Log, 0)
-	return base.Return(resp, db.GetPagination()(q, req.Page, req.Limit, &resp.Total, &resp.List))
 }
 
-func (s *service) Get(req GetReq) (resp GetResp, err error) {
-	var list []models.Log
-	if err = db.GetDB().Model(new(models.Log)).Where("id=?", req.Id).Find(&list).Error; err != nil {
+func (s *Service) GetPage(req GetPageReq) (resp GetPageResp, err error) {
+	return s.GetPageCtx(context.Background(), req)
+}
+
+func (s *Service) GetPageCtx(ctx context.Context, req GetPageReq) (resp GetPageResp, err error) {
+	if resp.Total, resp.List, err = s.GetStore().GetPage(ctx, req); resp.List == nil {
+		resp.List = make([]models.Log, 0)
+	}
+	return
+}
+
+func (s *Service) Get(req GetReq) (resp GetResp, err error) {
+	return s.GetCtx(context.Background(), req)
+}
+
+func (s *Service) GetCtx(ctx context.Context, req GetReq) (resp GetResp, err error) {
+	var entry *models.Log
+	if entry, err = s.GetStore().Get(ctx, req.Id); err != nil {
 		return
 	}
-	if len(list) == 0 {
+	if entry == nil {
 		err = errors.New(fmt.Sprintf("日志[%d]不存在", req.Id))
 		return
 	}
-	resp.Log = list[0]
+	resp.Log = *entry
 	return
 }
 
-func (s *service) Add(req AddReq) (err error) {
-	return base.Callback1(db.GetDB().Create(req.transform()).Error, req, req.Callback)
+func (s *Service) Add(req AddReq) (err error) {
+	return s.AddCtx(context.Background(), req)
+}
+
+func (s *Service) AddCtx(ctx context.Context, req AddReq) (err error) {
+	// Log entries inside a transaction must be written synchronously to commit or roll back with it.
+	if _, inTx := db.TxFromContext(ctx); !inTx {
+		if w := s.getAsync(); w != nil {
+			return w.AddCtx(ctx, req)
+		}
+	}
+	return base.Callback1(s.GetStore().Add(ctx, req.transform()), req, req.Callback)
+}
+
+func (s *Service) AddTx(tx *gorm.DB, req AddReq) (err error) {
+	if tx == nil {
+		return ErrNilTx
+	}
+	// Keep the deadline and cancellation of the context the transaction is bound to.
+	ctx := context.Background()
+	if tx.Statement != nil && tx.Statement.Context != nil {
+		ctx = tx.Statement.Context
+	}
+	return s.AddCtx(db.ContextWithTx(ctx, tx), req)
+}
+
+func (s *Service) AddBatch(reqs []AddReq) (err error) {
+	return s.AddBatchCtx(context.Background(), reqs)
+}
+
+func (s *Service) AddBatchCtx(ctx context.Context, reqs []AddReq) (err error) {
+	entries := make([]*models.Log, len(reqs))
+	for i := range reqs {
+		entries[i] = reqs[i].transform()
+	}
+	return s.addBatch(ctx, reqs, entries)
+}
+
+// addBatch adds the log entries created from the requests in batches and runs the callbacks of the added ones.
+// If the batch fails, the log entries are added one by one, returning a *BatchError with the per-request errors.
+// Inside a caller's transaction, the callbacks run before the transaction commits, and are not undone by a rollback.
+func (s *Service) addBatch(ctx context.Context, reqs []AddReq, entries []*models.Log) (err error) {
+	if len(entries) == 0 {
+		return
+	}
+	st := s.GetStore()
+	if err = st.AddBatch(ctx, entries); err == nil {
+		for _, req := range reqs {
+			_ = base.Callback1(nil, req, req.Callback)
+		}
+		return
+	}
+	// The Store rolls back a failed batch as a whole, so find out which log entries fail on their own.
+	batchErr := &BatchError{Errs: make([]error, len(entries))}
+	for i, entry := range entries {
+		entry.Id = 0
+		if batchErr.Errs[i] = base.Callback1(st.Add(ctx, entry), reqs[i], reqs[i].Callback); batchErr.Errs[i] != nil {
+			batchErr.Failed++
+		}
+	}
+	if batchErr.Failed > 0 {
+		return batchErr
+	}
+	return nil
+}
+
+func (s *Service) Update(req UpdateReq) (err error) {
+	return s.UpdateCtx(context.Background(), req)
+}
+
+func (s *Service) UpdateCtx(ctx context.Context, req UpdateReq) (err error) {
+	return base.Callback1(s.GetStore().Update(ctx, req.transform()), req, req.Callback)
 }
 
-func (s *service) Update(req UpdateReq) (err error) {
-	return base.Callback1(db.GetDB().Model(&models.Log{Id: req.Id}).Updates(req.transform()).Error, req, req.Callback)
+func (s *Service) Delete(req DeleteReq) (err error) {
+	return s.DeleteCtx(context.Background(), req)
 }
 
-func (s *service) Delete(req DeleteReq) (err error) {
-	return base.Callback1(db.GetDB().Delete(&models.Log{Id: req.Id}).Error, req, req.Callback)
+func (s *Service) DeleteCtx(ctx context.Context, req DeleteReq) (err error) {
+	return base.Callback1(s.GetStore().Delete(ctx, req.Id), req, req.Callback)
 }
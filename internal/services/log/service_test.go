@@ -0,0 +1,179 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-the-way/unilog/internal/models"
+	"gorm.io/gorm"
+)
+
+// fakeStore is a Store recording added log entries, failing with err if set.
+type fakeStore struct {
+	Store
+	entries []models.Log
+	err     error
+}
+
+func (s *fakeStore) Add(_ context.Context, entry *models.Log) (err error) {
+	if s.err != nil {
+		return s.err
+	}
+	entry.Id = uint(len(s.entries) + 1)
+	s.entries = append(s.entries, *entry)
+	return
+}
+
+func (s *fakeStore) Get(_ context.Context, id uint) (entry *models.Log, err error) {
+	if id == 0 || int(id) > len(s.entries) {
+		return nil, s.err
+	}
+	return &s.entries[id-1], s.err
+}
+
+// useStore makes the log service use a Store until the test ends.
+func useStore(t *testing.T, st Store) {
+	prev := GetStore()
+	SetStore(st)
+	t.Cleanup(func() { SetStore(prev) })
+}
+
+func TestServiceAddUsesStore(t *testing.T) {
+	st := &fakeStore{}
+	useStore(t, st)
+
+	var called []AddReq
+	req := AddReq{UserId: 1, Type1: "admin", Content: "created", Callback: func(req AddReq) { called = append(called, req) }}
+	if err := s.Add(req); err != nil {
+		t.Fatalf("Add() = %v", err)
+	}
+	if len(st.entries) != 1 || st.entries[0].Type1 != "admin" || st.entries[0].Content != "created" || st.entries[0].CreateTime == "" {
+		t.Fatalf("store entries = %+v, want the added entry with a create time", st.entries)
+	}
+	if len(called) != 1 {
+		t.Fatalf("callback called %d times, want 1", len(called))
+	}
+
+	resp, err := s.Get(GetReq{Id: 1})
+	if err != nil || resp.Content != "created" {
+		t.Fatalf("Get() = %+v, %v, want the added entry", resp, err)
+	}
+	if _, err = s.Get(GetReq{Id: 2}); err == nil {
+		t.Fatal("Get() of a missing entry returned no error")
+	}
+}
+
+func TestServiceAddStoreError(t *testing.T) {
+	errStore := errors.New("store unavailable")
+	useStore(t, &fakeStore{err: errStore})
+
+	called := false
+	if err := s.Add(AddReq{Callback: func(AddReq) { called = true }}); !errors.Is(err, errStore) {
+		t.Fatalf("Add() = %v, want %v", err, errStore)
+	}
+	if called {
+		t.Fatal("callback called although the entry was not added")
+	}
+}
+
+// partialStore is a Store whose AddBatch always fails and whose Add fails for the contents in bad.
+type partialStore struct {
+	Store
+	bad map[string]bool
+}
+
+func (s *partialStore) AddBatch(context.Context, []*models.Log) (err error) {
+	return errors.New("batch rejected")
+}
+
+func (s *partialStore) Add(ctx context.Context, entry *models.Log) (err error) {
+	if s.bad[entry.Content] {
+		return errors.New("entry rejected: " + entry.Content)
+	}
+	return s.Store.Add(ctx, entry)
+}
+
+func TestServiceAddBatch(t *testing.T) {
+	st := NewMemoryStore()
+	var called []string
+	callback := func(req AddReq) { called = append(called, req.Content) }
+	reqs := []AddReq{{Content: "a", Callback: callback}, {Content: "b", Callback: callback}}
+	if err := NewService(st).AddBatch(reqs); err != nil {
+		t.Fatalf("AddBatch() = %v", err)
+	}
+	if total, _, _ := st.GetPage(context.Background(), GetPageReq{}); total != 2 {
+		t.Fatalf("added %d log entries, want 2", total)
+	}
+	if len(called) != 2 || called[0] != "a" || called[1] != "b" {
+		t.Fatalf("callbacks called for %v, want [a b]", called)
+	}
+}
+
+func TestServiceAddBatchRetriesEntries(t *testing.T) {
+	st := &partialStore{Store: NewMemoryStore(), bad: map[string]bool{"b": true, "d": true}}
+	var called []string
+	callback := func(req AddReq) { called = append(called, req.Content) }
+	var reqs []AddReq
+	for _, content := range []string{"a", "b", "c", "d"} {
+		reqs = append(reqs, AddReq{Content: content, Callback: callback})
+	}
+
+	err := NewService(st).AddBatch(reqs)
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("AddBatch() = %v, want a *BatchError", err)
+	}
+	if batchErr.Failed != 2 || len(batchErr.Errs) != 4 {
+		t.Fatalf("BatchError = %d failed of %d, want 2 of 4", batchErr.Failed, len(batchErr.Errs))
+	}
+	for i, content := range []string{"a", "b", "c", "d"} {
+		if failed := batchErr.Errs[i] != nil; failed != st.bad[content] {
+			t.Errorf("Errs[%d] = %v for %q, want failed = %t", i, batchErr.Errs[i], content, st.bad[content])
+		}
+	}
+
+	// Only the entries added on their own are persisted, and only their callbacks run.
+	_, list, _ := st.GetPage(context.Background(), GetPageReq{OrderBy: "id"})
+	if len(list) != 2 || list[0].Content != "a" || list[1].Content != "c" {
+		t.Fatalf("added %+v, want the entries a and c", list)
+	}
+	if len(called) != 2 || called[0] != "a" || called[1] != "c" {
+		t.Fatalf("callbacks called for %v, want [a c]", called)
+	}
+}
+
+func TestServiceAddBatchRetriesAllSucceed(t *testing.T) {
+	st := &partialStore{Store: NewMemoryStore()}
+	if err := NewService(st).AddBatch([]AddReq{{Content: "a"}, {Content: "b"}}); err != nil {
+		t.Fatalf("AddBatch() with all entries added on retry = %v, want nil", err)
+	}
+	if total, _, _ := st.GetPage(context.Background(), GetPageReq{}); total != 2 {
+		t.Fatalf("added %d log entries, want 2", total)
+	}
+}
+
+func TestServiceAddTxNil(t *testing.T) {
+	st := &fakeStore{}
+	svc := NewService(st)
+	if err := svc.AddTx(nil, AddReq{Content: "created"}); !errors.Is(err, ErrNilTx) {
+		t.Fatalf("AddTx(nil) = %v, want %v", err, ErrNilTx)
+	}
+	if err := svc.AddTx(&gorm.DB{}, AddReq{Content: "created"}); err != nil {
+		t.Fatalf("AddTx() without a statement = %v", err)
+	}
+	if len(st.entries) != 1 {
+		t.Fatalf("added %d log entries, want 1", len(st.entries))
+	}
+}
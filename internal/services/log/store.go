@@ -0,0 +1,37 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+
+	"github.com/go-the-way/unilog/internal/models"
+)
+
+// Store defines the persistence operations used by the log service.
+// Implementations can keep log entries in a database, files, memory or a message queue,
+// and should honor the cancellation and deadline of the given context.
+type Store interface {
+	// GetPage retrieves the log entries matching the request filters, along with the total count.
+	GetPage(ctx context.Context, req GetPageReq) (total int64, list []models.Log, err error)
+	// Get retrieves a log entry by its Id, returning nil if it does not exist.
+	Get(ctx context.Context, id uint) (entry *models.Log, err error)
+	// Add persists a new log entry.
+	Add(ctx context.Context, entry *models.Log) (err error)
+	// AddBatch persists multiple new log entries at once, e.g. as a multi-row insert.
+	// It must be atomic: if it fails, none of the log entries are persisted.
+	AddBatch(ctx context.Context, entries []*models.Log) (err error)
+	// Update modifies an existing log entry identified by its Id, leaving its create time unchanged.
+	Update(ctx context.Context, entry *models.Log) (err error)
+	// Delete removes a log entry by its Id.
+	Delete(ctx context.Context, id uint) (err error)
+}
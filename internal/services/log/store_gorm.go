@@ -0,0 +1,159 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-the-way/unilog/internal/db"
+	"github.com/go-the-way/unilog/internal/models"
+	"github.com/go-the-way/unilog/internal/pkg"
+	"gorm.io/gorm"
+)
+
+// Ensure gormStore implements the Store interface.
+var _ Store = (*gormStore)(nil)
+
+// gormBatchSize is the maximum number of rows per insert statement in AddBatch.
+const gormBatchSize = 500
+
+// ErrNoDB is returned by a gorm Store when it has no database and no global database is set through SetDB.
+var ErrNoDB = errors.New("unilog: no database configured, call SetDB")
+
+// gormStore is a Store backed by a gorm database.
+type gormStore struct {
+	gdb      *gorm.DB          // gdb is the database to use, nil means the global database set through SetDB.
+	pageFunc db.PaginationFunc // pageFunc is the pagination function, nil means the global one set through SetPagination.
+}
+
+// NewGormStore creates a Store backed by the given gorm database and optional pagination function.
+// A nil database or pagination function makes the Store use the global one set through SetDB or SetPagination.
+func NewGormStore(gdb *gorm.DB, pagination ...db.PaginationFunc) Store {
+	s := &gormStore{gdb: gdb}
+	if len(pagination) > 0 {
+		s.pageFunc = pagination[0]
+	}
+	return s
+}
+
+// getDB retrieves the database used by the Store, bound to the given context.
+// A transaction carried by the context takes precedence over the Store's database.
+// Returns ErrNoDB if there is neither a Store's nor a global database.
+func (s *gormStore) getDB(ctx context.Context) (gdb *gorm.DB, err error) {
+	if tx, ok := db.TxFromContext(ctx); ok {
+		return tx.WithContext(ctx), nil
+	}
+	if gdb = s.gdb; gdb == nil {
+		if gdb = db.GetDB(); gdb == nil {
+			return nil, ErrNoDB
+		}
+	}
+	return gdb.WithContext(ctx), nil
+}
+
+func (s *gormStore) GetPage(ctx context.Context, req GetPageReq) (total int64, list []models.Log, err error) {
+	gdb, err := s.getDB(ctx)
+	if err != nil {
+		return
+	}
+	q := gdb.Model(new(models.Log))
+	pkg.IfGt0Func(req.Id, func() { q.Where("id=?", req.Id) })
+	pkg.IfGt0Func(req.UserId, func() { q.Where("user_id=?", req.UserId) })
+	pkg.IfNotEmptyFunc(req.UserName, func() { q.Where("user_name like concat('%',?,'%')", req.UserName) })
+	pkg.IfNotEmptyFunc(req.ClientIP, func() { q.Where("client_ip like concat('%',?,'%')", req.ClientIP) })
+	pkg.IfNotEmptyFunc(req.Type1, func() { q.Where("type1=?", req.Type1) })
+	pkg.IfNotEmptyFunc(req.Type2, func() { q.Where("type2=?", req.Type2) })
+	pkg.IfNotEmptyFunc(req.Type3, func() { q.Where("type3=?", req.Type3) })
+	pkg.IfNotEmptyFunc(req.Type4, func() { q.Where("type4=?", req.Type4) })
+	pkg.IfNotEmptyFunc(req.Type5, func() { q.Where("type5=?", req.Type5) })
+	pkg.IfNotEmptyFunc(req.Content, func() { q.Where("content like concat('%',?,'%')", req.Content) })
+	pkg.IfNotEmptyFunc(req.CreateTime1, func() { q.Where("create_time>=concat(?,' 00:00:00')", req.CreateTime1) })
+	pkg.IfNotEmptyFunc(req.CreateTime2, func() { q.Where("create_time<=concat(?,' 23:59:59')", req.CreateTime2) })
+	pkg.IfNotEmptyFunc(req.UpdateTime1, func() { q.Where("update_time>=concat(?,' 00:00:00')", req.UpdateTime1) })
+	pkg.IfNotEmptyFunc(req.UpdateTime2, func() { q.Where("update_time<=concat(?,' 23:59:59')", req.UpdateTime2) })
+	if req.OrderBy != "" {
+		q.Order(req.OrderBy)
+	}
+	list = make([]models.Log, 0)
+	pageFunc := s.pageFunc
+	if pageFunc == nil {
+		pageFunc = db.GetPagination()
+	}
+	err = pageFunc(q, req.Page, req.Limit, &total, &list)
+	return
+}
+
+func (s *gormStore) Get(ctx context.Context, id uint) (entry *models.Log, err error) {
+	gdb, err := s.getDB(ctx)
+	if err != nil {
+		return
+	}
+	var list []models.Log
+	if err = gdb.Model(new(models.Log)).Where("id=?", id).Find(&list).Error; err != nil || len(list) == 0 {
+		return
+	}
+	return &list[0], nil
+}
+
+func (s *gormStore) Add(ctx context.Context, entry *models.Log) (err error) {
+	gdb, err := s.getDB(ctx)
+	if err != nil {
+		return
+	}
+	// Inside a caller's transaction, insert under a savepoint so that a failed insert
+	// does not leave the transaction aborted for the statements that follow.
+	if _, inTx := db.TxFromContext(ctx); inTx {
+		return gdb.Transaction(func(tx *gorm.DB) error { return tx.Create(entry).Error })
+	}
+	return gdb.Create(entry).Error
+}
+
+func (s *gormStore) AddBatch(ctx context.Context, entries []*models.Log) (err error) {
+	gdb, err := s.getDB(ctx)
+	if err != nil {
+		return
+	}
+	// CreateInBatches commits each chunk on its own when SkipDefaultTransaction is set,
+	// so insert all chunks in a transaction, or under a savepoint inside a caller's transaction.
+	return gdb.Transaction(func(tx *gorm.DB) error {
+		return tx.CreateInBatches(entries, gormBatchSize).Error
+	})
+}
+
+func (s *gormStore) Update(ctx context.Context, entry *models.Log) (err error) {
+	gdb, err := s.getDB(ctx)
+	if err != nil {
+		return
+	}
+	return gdb.Model(&models.Log{Id: entry.Id}).Updates(map[string]any{
+		"user_id":      entry.UserId,
+		"user_name":    entry.UserName,
+		"client_ip":    entry.ClientIP,
+		"type1":        entry.Type1,
+		"type2":        entry.Type2,
+		"type3":        entry.Type3,
+		"type4":        entry.Type4,
+		"type5":        entry.Type5,
+		"content":      entry.Content,
+		"content_json": entry.ContentJSON,
+		"update_time":  entry.UpdateTime,
+	}).Error
+}
+
+func (s *gormStore) Delete(ctx context.Context, id uint) (err error) {
+	gdb, err := s.getDB(ctx)
+	if err != nil {
+		return
+	}
+	return gdb.Delete(&models.Log{Id: id}).Error
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-the-way/unilog/internal/models"
+)
+
+func TestGormStoreNoDB(t *testing.T) {
+	st := NewGormStore(nil)
+	ctx := context.Background()
+	if _, _, err := st.GetPage(ctx, GetPageReq{}); !errors.Is(err, ErrNoDB) {
+		t.Errorf("GetPage() = %v, want %v", err, ErrNoDB)
+	}
+	if _, err := st.Get(ctx, 1); !errors.Is(err, ErrNoDB) {
+		t.Errorf("Get() = %v, want %v", err, ErrNoDB)
+	}
+	if err := st.Add(ctx, &models.Log{}); !errors.Is(err, ErrNoDB) {
+		t.Errorf("Add() = %v, want %v", err, ErrNoDB)
+	}
+	if err := st.AddBatch(ctx, []*models.Log{{}}); !errors.Is(err, ErrNoDB) {
+		t.Errorf("AddBatch() = %v, want %v", err, ErrNoDB)
+	}
+	if err := st.Update(ctx, &models.Log{Id: 1}); !errors.Is(err, ErrNoDB) {
+		t.Errorf("Update() = %v, want %v", err, ErrNoDB)
+	}
+	if err := st.Delete(ctx, 1); !errors.Is(err, ErrNoDB) {
+		t.Errorf("Delete() = %v, want %v", err, ErrNoDB)
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-the-way/unilog/internal/models"
+)
+
+// Ensure memoryStore implements the Store interface.
+var _ Store = (*memoryStore)(nil)
+
+// memoryStore is a thread-safe Store keeping log entries in memory, intended for tests and local development.
+type memoryStore struct {
+	mu     sync.RWMutex
+	lastId uint         // lastId is the last assigned log Id.
+	list   []models.Log // list holds the log entries in insertion order.
+}
+
+// NewMemoryStore creates an empty Store keeping log entries in memory.
+func NewMemoryStore() Store {
+	return &memoryStore{}
+}
+
+// memoryColumns maps the sortable column names to functions comparing two log entries by that column.
+var memoryColumns = map[string]func(a, b *models.Log) int{
+	"id":          func(a, b *models.Log) int { return compareUint(a.Id, b.Id) },
+	"user_id":     func(a, b *models.Log) int { return compareUint(a.UserId, b.UserId) },
+	"user_name":   func(a, b *models.Log) int { return strings.Compare(a.UserName, b.UserName) },
+	"client_ip":   func(a, b *models.Log) int { return strings.Compare(a.ClientIP, b.ClientIP) },
+	"type1":       func(a, b *models.Log) int { return strings.Compare(a.Type1, b.Type1) },
+	"type2":       func(a, b *models.Log) int { return strings.Compare(a.Type2, b.Type2) },
+	"type3":       func(a, b *models.Log) int { return strings.Compare(a.Type3, b.Type3) },
+	"type4":       func(a, b *models.Log) int { return strings.Compare(a.Type4, b.Type4) },
+	"type5":       func(a, b *models.Log) int { return strings.Compare(a.Type5, b.Type5) },
+	"content":     func(a, b *models.Log) int { return strings.Compare(a.Content, b.Content) },
+	"create_time": func(a, b *models.Log) int { return strings.Compare(a.CreateTime, b.CreateTime) },
+	"update_time": func(a, b *models.Log) int { return strings.Compare(a.UpdateTime, b.UpdateTime) },
+}
+
+func compareUint(a, b uint) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func (s *memoryStore) GetPage(ctx context.Context, req GetPageReq) (total int64, list []models.Log, err error) {
+	if err = ctx.Err(); err != nil {
+		return
+	}
+	less, err := memoryOrderBy(req.OrderBy)
+	if err != nil {
+		return
+	}
+
+	s.mu.RLock()
+	list = make([]models.Log, 0)
+	for _, entry := range s.list {
+		if req.match(&entry) {
+			list = append(list, entry)
+		}
+	}
+	s.mu.RUnlock()
+
+	if less != nil {
+		sort.SliceStable(list, func(i, j int) bool { return less(&list[i], &list[j]) })
+	}
+
+	// Paginate the matched entries, returning all of them if no limit is given.
+	total = int64(len(list))
+	if req.Limit > 0 {
+		page := req.Page
+		if page < 1 {
+			page = 1
+		}
+		start, end := (page-1)*req.Limit, page*req.Limit
+		if start > len(list) {
+			start = len(list)
+		}
+		if end > len(list) {
+			end = len(list)
+		}
+		list = list[start:end]
+	}
+	return
+}
+
+func (s *memoryStore) Get(ctx context.Context, id uint) (entry *models.Log, err error) {
+	if err = ctx.Err(); err != nil {
+		return
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if i := s.index(id); i >= 0 {
+		entry0 := s.list[i]
+		entry = &entry0
+	}
+	return
+}
+
+func (s *memoryStore) Add(ctx context.Context, entry *models.Log) (err error) {
+	if err = ctx.Err(); err != nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.add(entry)
+}
+
+func (s *memoryStore) AddBatch(ctx context.Context, entries []*models.Log) (err error) {
+	if err = ctx.Err(); err != nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Check all entries first, so that a failed batch adds nothing like a database transaction.
+	seen := map[uint]struct{}{}
+	for _, entry := range entries {
+		if entry.Id == 0 {
+			continue
+		}
+		if _, ok := seen[entry.Id]; ok || s.index(entry.Id) >= 0 {
+			return fmt.Errorf("日志[%d]已存在", entry.Id)
+		}
+		seen[entry.Id] = struct{}{}
+	}
+	for _, entry := range entries {
+		if err = s.add(entry); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// add appends a log entry, assigning it a new Id if it has none. The caller must hold the write lock.
+func (s *memoryStore) add(entry *models.Log) (err error) {
+	if entry.Id == 0 {
+		s.lastId++
+		entry.Id = s.lastId
+	} else if s.index(entry.Id) >= 0 {
+		return fmt.Errorf("日志[%d]已存在", entry.Id)
+	} else if entry.Id > s.lastId {
+		s.lastId = entry.Id
+	}
+	s.list = append(s.list, *entry)
+	return
+}
+
+func (s *memoryStore) Update(ctx context.Context, entry *models.Log) (err error) {
+	if err = ctx.Err(); err != nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i := s.index(entry.Id); i >= 0 {
+		entry0 := *entry
+		entry0.CreateTime = s.list[i].CreateTime
+		s.list[i] = entry0
+	}
+	return
+}
+
+func (s *memoryStore) Delete(ctx context.Context, id uint) (err error) {
+	if err = ctx.Err(); err != nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i := s.index(id); i >= 0 {
+		s.list = append(s.list[:i], s.list[i+1:]...)
+	}
+	return
+}
+
+// index returns the position of the log entry with the given Id, or -1 if it does not exist.
+func (s *memoryStore) index(id uint) int {
+	for i := range s.list {
+		if s.list[i].Id == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// match reports whether the log entry satisfies all filters of the request.
+func (req *GetPageReq) match(entry *models.Log) bool {
+	contains := func(s, substr string) bool { return substr == "" || strings.Contains(s, substr) }
+	equals := func(s, want string) bool { return want == "" || s == want }
+	return (req.Id == 0 || entry.Id == req.Id) &&
+		(req.UserId == 0 || entry.UserId == req.UserId) &&
+		contains(entry.UserName, req.UserName) &&
+		contains(entry.ClientIP, req.ClientIP) &&
+		equals(entry.Type1, req.Type1) &&
+		equals(entry.Type2, req.Type2) &&
+		equals(entry.Type3, req.Type3) &&
+		equals(entry.Type4, req.Type4) &&
+		equals(entry.Type5, req.Type5) &&
+		contains(entry.Content, req.Content) &&
+		(req.CreateTime1 == "" || entry.CreateTime >= req.CreateTime1+" 00:00:00") &&
+		(req.CreateTime2 == "" || entry.CreateTime <= req.CreateTime2+" 23:59:59") &&
+		(req.UpdateTime1 == "" || entry.UpdateTime >= req.UpdateTime1+" 00:00:00") &&
+		(req.UpdateTime2 == "" || entry.UpdateTime <= req.UpdateTime2+" 23:59:59")
+}
+
+// memoryOrderBy parses an order by clause (e.g., "create_time desc,id") into a less function.
+// It returns a nil function for an empty clause, and an error for an unknown column or direction.
+func memoryOrderBy(orderBy string) (less func(a, b *models.Log) bool, err error) {
+	type order struct {
+		compare func(a, b *models.Log) int
+		desc    bool
+	}
+	var orders []order
+	for _, item := range strings.Split(orderBy, ",") {
+		parts := strings.Fields(strings.ReplaceAll(item, "`", ""))
+		if len(parts) == 0 {
+			continue
+		}
+		compare, ok := memoryColumns[strings.ToLower(parts[0])]
+		if !ok || len(parts) > 2 {
+			return nil, fmt.Errorf("排序[%s]不支持", strings.TrimSpace(item))
+		}
+		o := order{compare: compare}
+		if len(parts) == 2 {
+			switch strings.ToLower(parts[1]) {
+			default:
+				return nil, fmt.Errorf("排序[%s]不支持", strings.TrimSpace(item))
+			case "asc":
+			case "desc":
+				o.desc = true
+			}
+		}
+		orders = append(orders, o)
+	}
+	if len(orders) == 0 {
+		return
+	}
+	return func(a, b *models.Log) bool {
+		for _, o := range orders {
+			if c := o.compare(a, b); c != 0 {
+				return (c < 0) != o.desc
+			}
+		}
+		return false
+	}, nil
+}
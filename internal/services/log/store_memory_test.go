@@ -0,0 +1,170 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-the-way/unilog/internal/models"
+)
+
+// newTestMemoryStore returns a memory Store holding log entries of two users over three days.
+func newTestMemoryStore(t *testing.T) Store {
+	st := NewMemoryStore()
+	entries := []*models.Log{
+		{UserId: 1, UserName: "kellen", ClientIP: "10.0.0.1", Type1: "order", Content: "created order 1", CreateTime: "2025-01-01 09:00:00", UpdateTime: "2025-01-01 09:00:00"},
+		{UserId: 2, UserName: "polo", ClientIP: "10.0.0.2", Type1: "order", Content: "created order 2", CreateTime: "2025-01-02 10:00:00", UpdateTime: "2025-01-03 10:00:00"},
+		{UserId: 1, UserName: "kellen", ClientIP: "10.0.1.1", Type1: "user", Content: "renamed user", CreateTime: "2025-01-03 11:00:00", UpdateTime: "2025-01-03 11:00:00"},
+		{UserId: 2, UserName: "polo", ClientIP: "10.0.0.2", Type1: "order", Content: "paid order 2", CreateTime: "2025-01-03 12:00:00", UpdateTime: "2025-01-03 12:00:00"},
+	}
+	if err := st.AddBatch(context.Background(), entries); err != nil {
+		t.Fatalf("AddBatch() = %v", err)
+	}
+	return st
+}
+
+// ids returns the Ids of the log entries, in order.
+func ids(list []models.Log) (idS []uint) {
+	for _, entry := range list {
+		idS = append(idS, entry.Id)
+	}
+	return
+}
+
+// equalIds reports whether two Id lists are equal.
+func equalIds(a, b []uint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMemoryStoreGetPageFilters(t *testing.T) {
+	st := newTestMemoryStore(t)
+	tests := []struct {
+		name string
+		req  GetPageReq
+		want []uint
+	}{
+		{"all", GetPageReq{}, []uint{1, 2, 3, 4}},
+		{"id", GetPageReq{Id: 3}, []uint{3}},
+		{"user id", GetPageReq{UserId: 2}, []uint{2, 4}},
+		{"user name contains", GetPageReq{UserName: "ell"}, []uint{1, 3}},
+		{"client ip contains", GetPageReq{ClientIP: "10.0.0."}, []uint{1, 2, 4}},
+		{"type equals", GetPageReq{Type1: "ord"}, nil},
+		{"type and content", GetPageReq{Type1: "order", Content: "order 2"}, []uint{2, 4}},
+		{"create time range", GetPageReq{CreateTime1: "2025-01-02", CreateTime2: "2025-01-02"}, []uint{2}},
+		{"update time from", GetPageReq{UpdateTime1: "2025-01-03"}, []uint{2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, list, err := st.GetPage(context.Background(), tt.req)
+			if err != nil {
+				t.Fatalf("GetPage() = %v", err)
+			}
+			if got := ids(list); !equalIds(got, tt.want) || total != int64(len(tt.want)) {
+				t.Fatalf("GetPage() = %d, %v, want %d, %v", total, got, len(tt.want), tt.want)
+			}
+		})
+	}
+}
+
+func TestMemoryStoreGetPageOrderBy(t *testing.T) {
+	st := newTestMemoryStore(t)
+	tests := []struct {
+		orderBy string
+		want    []uint
+	}{
+		{"", []uint{1, 2, 3, 4}},
+		{"id desc", []uint{4, 3, 2, 1}},
+		{"user_id,id DESC", []uint{3, 1, 4, 2}},
+		{"`update_time` desc, id asc", []uint{4, 3, 2, 1}},
+		{"type1 desc,create_time", []uint{3, 1, 2, 4}},
+	}
+	for _, tt := range tests {
+		_, list, err := st.GetPage(context.Background(), GetPageReq{OrderBy: tt.orderBy})
+		if err != nil {
+			t.Fatalf("GetPage(%q) = %v", tt.orderBy, err)
+		}
+		if got := ids(list); !equalIds(got, tt.want) {
+			t.Errorf("GetPage(%q) = %v, want %v", tt.orderBy, got, tt.want)
+		}
+	}
+}
+
+func TestMemoryStoreGetPageOrderByInvalid(t *testing.T) {
+	st := newTestMemoryStore(t)
+	for _, orderBy := range []string{"password", "id sideways", "id desc nulls", "id; drop table unilog_log"} {
+		if _, _, err := st.GetPage(context.Background(), GetPageReq{OrderBy: orderBy}); err == nil {
+			t.Errorf("GetPage(%q) returned no error", orderBy)
+		}
+	}
+}
+
+func TestMemoryStoreGetPagePagination(t *testing.T) {
+	st := newTestMemoryStore(t)
+	tests := []struct {
+		page, limit int
+		want        []uint
+	}{
+		{1, 3, []uint{1, 2, 3}},
+		{2, 3, []uint{4}},
+		{3, 3, nil},
+		{0, 2, []uint{1, 2}},
+		{2, 0, []uint{1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		total, list, err := st.GetPage(context.Background(), GetPageReq{Page: tt.page, Limit: tt.limit})
+		if err != nil {
+			t.Fatalf("GetPage() = %v", err)
+		}
+		if got := ids(list); !equalIds(got, tt.want) || total != 4 {
+			t.Errorf("GetPage(page %d, limit %d) = %d, %v, want 4, %v", tt.page, tt.limit, total, got, tt.want)
+		}
+	}
+}
+
+func TestMemoryStoreCRUD(t *testing.T) {
+	st := newTestMemoryStore(t)
+	ctx := context.Background()
+	if err := st.Update(ctx, &models.Log{Id: 2, Content: "cancelled order 2", CreateTime: "2099-01-01 00:00:00"}); err != nil {
+		t.Fatalf("Update() = %v", err)
+	}
+	entry, err := st.Get(ctx, 2)
+	if err != nil || entry == nil || entry.Content != "cancelled order 2" || entry.CreateTime != "2025-01-02 10:00:00" {
+		t.Fatalf("Get() = %+v, %v, want the updated entry with its create time kept", entry, err)
+	}
+	if err = st.Delete(ctx, 2); err != nil {
+		t.Fatalf("Delete() = %v", err)
+	}
+	if entry, err = st.Get(ctx, 2); entry != nil || err != nil {
+		t.Fatalf("Get() of a deleted entry = %+v, %v, want nil, nil", entry, err)
+	}
+	if err = st.AddBatch(ctx, []*models.Log{{}, {Id: 3}}); err == nil {
+		t.Fatal("AddBatch() with an existing Id returned no error")
+	}
+	if total, _, _ := st.GetPage(ctx, GetPageReq{}); total != 3 {
+		t.Fatalf("GetPage() total after a failed batch = %d, want 3", total)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err = st.Add(cancelled, &models.Log{}); err != context.Canceled {
+		t.Fatalf("Add() with a cancelled context = %v, want %v", err, context.Canceled)
+	}
+}
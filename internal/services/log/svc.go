@@ -11,10 +11,24 @@
 
 package log
 
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
 type svc interface {
 	GetPage(req GetPageReq) (resp GetPageResp, err error)
+	GetPageCtx(ctx context.Context, req GetPageReq) (resp GetPageResp, err error)
 	Get(req GetReq) (resp GetResp, err error)
+	GetCtx(ctx context.Context, req GetReq) (resp GetResp, err error)
 	Add(req AddReq) (err error)
+	AddCtx(ctx context.Context, req AddReq) (err error)
+	AddTx(tx *gorm.DB, req AddReq) (err error)
+	AddBatch(reqs []AddReq) (err error)
+	AddBatchCtx(ctx context.Context, reqs []AddReq) (err error)
 	Update(req UpdateReq) (err error)
+	UpdateCtx(ctx context.Context, req UpdateReq) (err error)
 	Delete(req DeleteReq) (err error)
+	DeleteCtx(ctx context.Context, req DeleteReq) (err error)
 }
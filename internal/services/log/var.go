@@ -12,10 +12,26 @@
 package log
 
 var (
-	s       svc = &service{}
-	GetPage     = s.GetPage
-	Get         = s.Get
-	Add         = s.Add
-	Update      = s.Update
-	Delete      = s.Delete
+	s               = NewService(nil)
+	GetPage         = s.GetPage
+	GetPageCtx      = s.GetPageCtx
+	Get             = s.Get
+	GetCtx          = s.GetCtx
+	Add             = s.Add
+	AddCtx          = s.AddCtx
+	AddTx           = s.AddTx
+	AddBatch        = s.AddBatch
+	AddBatchCtx     = s.AddBatchCtx
+	Update          = s.Update
+	UpdateCtx       = s.UpdateCtx
+	Delete          = s.Delete
+	DeleteCtx       = s.DeleteCtx
+	SetStore        = s.SetStore
+	GetStore        = s.GetStore
+	SetErrorHandler = s.SetErrorHandler
+	HandleError     = s.HandleError
+	StartAsync      = s.StartAsync
 )
+
+// Default returns the Service used by the package-level functions.
+func Default() *Service { return s }
@@ -11,6 +11,26 @@
 
 package unilog
 
+// Chain returns a CallbackFunc that applies the given CallbackFuncs in order, skipping nil ones.
+func Chain(opts ...CallbackFunc) CallbackFunc {
+	return func(req *LogAddReq) {
+		for _, opt := range opts {
+			if opt != nil {
+				opt(req)
+			}
+		}
+	}
+}
+
+// When returns a CallbackFunc that applies the given CallbackFunc only if cond is true.
+// The returned CallbackFunc is never nil, so it can also be called directly.
+func When(cond bool, opt CallbackFunc) CallbackFunc {
+	if !cond || opt == nil {
+		return func(*LogAddReq) {}
+	}
+	return opt
+}
+
 // UserId returns a CallbackFunc that sets the UserId field of a LogAddReq.
 func UserId(a uint) CallbackFunc {
 	return func(req *LogAddReq) {
@@ -74,6 +94,20 @@ func Content(a string) CallbackFunc {
 	}
 }
 
+// ContentJSON returns a CallbackFunc that sets the ContentJSON field of a LogAddReq.
+func ContentJSON(a string) CallbackFunc {
+	return func(req *LogAddReq) {
+		req.ContentJSON = a
+	}
+}
+
+// FieldsJSON returns a CallbackFunc that sets the ContentJSON field of a LogAddReq to the JSON rendering of its fields.
+func FieldsJSON() CallbackFunc {
+	return func(req *LogAddReq) {
+		req.ContentJSON = req.Fields.JSON()
+	}
+}
+
 // Type1Admin returns a CallbackFunc that sets the Type1 field of a LogAddReq to "admin".
 func Type1Admin() CallbackFunc {
 	return Type1("admin")
@@ -0,0 +1,47 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unilog_test
+
+import (
+	"testing"
+
+	"github.com/go-the-way/unilog"
+)
+
+func TestWhen(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  unilog.CallbackFunc
+		want string
+	}{
+		{"true", unilog.When(true, unilog.Type3("export")), "export"},
+		{"false", unilog.When(false, unilog.Type3("export")), ""},
+		{"nil", unilog.When(true, nil), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req unilog.LogAddReq
+			tt.opt(&req)
+			if req.Type3 != tt.want {
+				t.Fatalf("Type3 = %q, want %q", req.Type3, tt.want)
+			}
+		})
+	}
+}
+
+func TestChain(t *testing.T) {
+	var req unilog.LogAddReq
+	unilog.Chain(unilog.Type1("order"), nil, unilog.When(false, unilog.Type1("user")), unilog.Type2("pay"))(&req)
+	if req.Type1 != "order" || req.Type2 != "pay" {
+		t.Fatalf("Type1, Type2 = %q, %q, want %q, %q", req.Type1, req.Type2, "order", "pay")
+	}
+}
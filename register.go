@@ -0,0 +1,26 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unilog
+
+import "github.com/go-the-way/unilog/internal/logger"
+
+// RegisterEnum registers the labels of an enum type, to be referenced by the "transform:enum" tag option
+// on fields of type T or *T. Registering a type again replaces its previous table.
+func RegisterEnum[T comparable](table map[T]string) {
+	logger.RegisterEnum(table)
+}
+
+// RegisterFormatter registers a formatter for the type T, used for fields of type T or *T
+// and for the elements and values of arrays/slices and maps, which are then logged as single values.
+func RegisterFormatter[T any](formatter func(v T) any) {
+	logger.RegisterFormatter(formatter)
+}
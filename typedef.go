@@ -38,6 +38,8 @@ type (
 	LogUserClientIP = logger.LogUserClientIP
 	// UC is a shorthand alias for LogUserClientIP, aliased from the logger package.
 	UC = logger.LogUserClientIP
+	// LogRenderer defines an optional method for choosing how the log fields are rendered, aliased from the logger package.
+	LogRenderer = logger.LogRenderer
 	// LogUser defines a method for retrieving user data for logging, aliased from the logger package.
 	LogUser = logger.LogUser
 	// LogClientIP defines a method for retrieving the client IP address, aliased from the logger package.
@@ -46,8 +48,47 @@ type (
 	Userdata = logger.Userdata
 	// Field represents a single log field with formatting and expression, aliased from the logger package.
 	Field = logger.Field
+	// UserdataFunc retrieves user data from a context, aliased from the logger package.
+	UserdataFunc = logger.UserdataFunc
+	// ClientIPFunc retrieves the client IP address from a context, aliased from the logger package.
+	ClientIPFunc = logger.ClientIPFunc
 	// FieldSlice is a slice of Field structs for logging multiple fields, aliased from the logger package.
 	FieldSlice = logger.FieldSlice
+	// ArrayFunc formats array/slice values in logs, aliased from the logger package.
+	ArrayFunc = logger.ArrayFunc
+	// MapFunc formats map values in logs, aliased from the logger package.
+	MapFunc = logger.MapFunc
+	// MapKeyComparator is a function type for ordering map keys, aliased from the logger package.
+	MapKeyComparator = logger.MapKeyComparator
+	// Masker is a function type for masking the string form of a field value, aliased from the logger package.
+	Masker = logger.Masker
+	// TransformFunc is a function type for transforming a field value into the value that is logged, aliased from the logger package.
+	TransformFunc = logger.TransformFunc
+	// TypeFormatter is a function type for converting a value of a registered type to the value that is logged, aliased from the logger package.
+	TypeFormatter = logger.TypeFormatter
+	// LogValuer is implemented by types that provide the value that is logged in place of themselves, aliased from the logger package.
+	LogValuer = logger.LogValuer
+	// NilPolicy defines how nil pointers and nil interfaces are logged, aliased from the logger package.
+	NilPolicy = logger.NilPolicy
+)
+
+// Type aliases for renderer-related interfaces and types.
+// These provide access to the renderers used for generating log strings from fields.
+type (
+	// Renderer is an interface for rendering a FieldSlice into a log string, aliased from the logger package.
+	Renderer = logger.Renderer
+	// BracketRenderer renders fields in the bracket style (e.g., "Name[Kellen]"), aliased from the logger package.
+	BracketRenderer = logger.BracketRenderer
+	// LogfmtRenderer renders fields as key=value pairs, aliased from the logger package.
+	LogfmtRenderer = logger.LogfmtRenderer
+	// JSONRenderer renders fields as a JSON object, aliased from the logger package.
+	JSONRenderer = logger.JSONRenderer
+	// TextRenderer renders fields in a human-readable multi-line form, aliased from the logger package.
+	TextRenderer = logger.TextRenderer
+	// TagError describes a problem with the log tag of a struct field, aliased from the logger package.
+	TagError = logger.TagError
+	// ValidationError aggregates the problems found by Validate, aliased from the logger package.
+	ValidationError = logger.ValidationError
 )
 
 // Type aliases for log service request and response types.
@@ -69,4 +110,16 @@ type (
 	LogGetPageResp = log.GetPageResp
 	// LogGetResp represents the response for a single log entry retrieval, aliased from the log package.
 	LogGetResp = log.GetResp
+	// Store defines the persistence operations used by the log service, aliased from the log package.
+	Store = log.Store
+	// AsyncConfig configures the asynchronous writer started by StartAsync, aliased from the log package.
+	AsyncConfig = log.AsyncConfig
+	// AsyncWriter adds log entries in the background in batches, aliased from the log package.
+	AsyncWriter = log.AsyncWriter
+	// LogBatchError reports the log entries of a batch that failed to be added, aliased from the log package.
+	LogBatchError = log.BatchError
+	// ErrorHandler handles an error from adding a log entry that cannot be returned to the caller, aliased from the log package.
+	ErrorHandler = log.ErrorHandler
+	// OverflowPolicy defines what the asynchronous writer does when its queue is full, aliased from the log package.
+	OverflowPolicy = log.OverflowPolicy
 )
@@ -0,0 +1,197 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unilog
+
+import (
+	"context"
+
+	"github.com/go-the-way/unilog/internal/db"
+	"github.com/go-the-way/unilog/internal/logger"
+	"github.com/go-the-way/unilog/internal/services/log"
+	"gorm.io/gorm"
+)
+
+// Unilog is an independent logging instance with its own database, pagination, store, renderer, and hooks.
+// The package-level functions use a default instance, configured through SetDB, SetStore, SetFieldFormat, etc.
+//
+// The other formatting settings are package-global and shared by all instances: the redaction policy,
+// nil policy, hash key, registered maskers, transforms, enums and type formatters, the map comparator,
+// and the field, array and map formats that a BracketRenderer falls back to for zero-valued options.
+type Unilog struct {
+	svc          *log.Service // svc holds the store and error handler of the instance.
+	gdb          *gorm.DB
+	pageFunc     PaginationFunc
+	renderer     Renderer
+	userdataFunc UserdataFunc
+	clientIPFunc ClientIPFunc
+}
+
+// std is the default instance used by the package-level functions.
+var std = &Unilog{svc: log.Default()}
+
+// Default returns the default instance used by the package-level functions.
+func Default() *Unilog { return std }
+
+// Option defines a function type for configuring a Unilog instance.
+type Option func(u *Unilog)
+
+// WithDB returns an Option that sets the gorm database of the instance.
+func WithDB(gdb *gorm.DB) Option {
+	return func(u *Unilog) {
+		u.gdb = gdb
+	}
+}
+
+// WithPagination returns an Option that sets the pagination function of the instance.
+func WithPagination(paginationFunc PaginationFunc) Option {
+	return func(u *Unilog) {
+		u.pageFunc = paginationFunc
+	}
+}
+
+// WithStore returns an Option that sets the Store of the instance, taking precedence over WithDB.
+func WithStore(st Store) Option {
+	return func(u *Unilog) {
+		u.svc.SetStore(st)
+	}
+}
+
+// WithRenderer returns an Option that sets the Renderer used for the log fields of the instance.
+func WithRenderer(renderer Renderer) Option {
+	return func(u *Unilog) {
+		u.renderer = renderer
+	}
+}
+
+// WithErrorHandler returns an Option that sets the handler invoked when the instance loses a log entry.
+func WithErrorHandler(handler ErrorHandler) Option {
+	return func(u *Unilog) {
+		u.svc.SetErrorHandler(handler)
+	}
+}
+
+// WithUserdataFunc returns an Option that sets the function retrieving user data from a context.
+func WithUserdataFunc(userdataFunc UserdataFunc) Option {
+	return func(u *Unilog) {
+		u.userdataFunc = userdataFunc
+	}
+}
+
+// WithClientIPFunc returns an Option that sets the function retrieving the client IP address from a context.
+func WithClientIPFunc(clientIPFunc ClientIPFunc) Option {
+	return func(u *Unilog) {
+		u.clientIPFunc = clientIPFunc
+	}
+}
+
+// New creates an independent Unilog instance configured by the given options.
+// Without WithStore, log entries are persisted to the gorm database set through WithDB,
+// falling back to the global database and pagination function for those not set.
+func New(opts ...Option) *Unilog {
+	u := &Unilog{svc: &log.Service{}}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(u)
+		}
+	}
+	if u.svc.GetStore() == nil {
+		u.svc.SetStore(NewGormStore(u.gdb, u.pageFunc))
+	}
+	return u
+}
+
+// userdataFromContext retrieves user data from a context using the instance's function, or the global one.
+func (u *Unilog) userdataFromContext(ctx context.Context) Userdata {
+	if u.userdataFunc != nil {
+		return u.userdataFunc(ctx)
+	}
+	return logger.UserdataFromContext(ctx)
+}
+
+// clientIPFromContext retrieves the client IP address from a context using the instance's function, or the global one.
+func (u *Unilog) clientIPFromContext(ctx context.Context) string {
+	if u.clientIPFunc != nil {
+		return u.clientIPFunc(ctx)
+	}
+	return logger.ClientIPFromContext(ctx)
+}
+
+// AutoMigrate automatically migrates the schema of the instance's database for the Log model.
+func (u *Unilog) AutoMigrate() (err error) {
+	if u.gdb != nil {
+		return db.Migrate(u.gdb)
+	}
+	return db.AutoMigrate()
+}
+
+// SetStore sets the Store used by the instance for persisting log entries.
+func (u *Unilog) SetStore(st Store) { u.svc.SetStore(st) }
+
+// GetStore retrieves the Store used by the instance for persisting log entries.
+func (u *Unilog) GetStore() Store { return u.svc.GetStore() }
+
+// SetErrorHandler sets the handler invoked when the instance loses a log entry.
+func (u *Unilog) SetErrorHandler(handler ErrorHandler) { u.svc.SetErrorHandler(handler) }
+
+// StartAsync starts an asynchronous writer and makes the instance's LogAdd enqueue log entries to it.
+func (u *Unilog) StartAsync(cfg AsyncConfig) *AsyncWriter { return u.svc.StartAsync(cfg) }
+
+// LogGetPage retrieves a paginated list of log entries.
+func (u *Unilog) LogGetPage(req LogGetPageReq) (LogGetPageResp, error) { return u.svc.GetPage(req) }
+
+// LogGetPageCtx retrieves a paginated list of log entries using the given context.
+func (u *Unilog) LogGetPageCtx(ctx context.Context, req LogGetPageReq) (LogGetPageResp, error) {
+	return u.svc.GetPageCtx(ctx, req)
+}
+
+// LogGet retrieves a single log entry by its identifier.
+func (u *Unilog) LogGet(req LogGetReq) (LogGetResp, error) { return u.svc.Get(req) }
+
+// LogGetCtx retrieves a single log entry by its identifier using the given context.
+func (u *Unilog) LogGetCtx(ctx context.Context, req LogGetReq) (LogGetResp, error) {
+	return u.svc.GetCtx(ctx, req)
+}
+
+// LogAdd creates a new log entry.
+func (u *Unilog) LogAdd(req LogAddReq) error { return u.svc.Add(req) }
+
+// LogAddCtx creates a new log entry using the given context.
+func (u *Unilog) LogAddCtx(ctx context.Context, req LogAddReq) error { return u.svc.AddCtx(ctx, req) }
+
+// LogAddTx creates a new log entry inside the given database transaction.
+// The callback of the request runs before the transaction commits, even if it is rolled back later.
+// Returns ErrNilTx if tx is nil.
+func (u *Unilog) LogAddTx(tx *gorm.DB, req LogAddReq) error { return u.svc.AddTx(tx, req) }
+
+// LogAddBatch creates multiple log entries in batches, returning a *LogBatchError on failure.
+func (u *Unilog) LogAddBatch(reqs []LogAddReq) error { return u.svc.AddBatch(reqs) }
+
+// LogAddBatchCtx creates multiple log entries in batches using the given context.
+func (u *Unilog) LogAddBatchCtx(ctx context.Context, reqs []LogAddReq) error {
+	return u.svc.AddBatchCtx(ctx, reqs)
+}
+
+// LogUpdate modifies an existing log entry.
+func (u *Unilog) LogUpdate(req LogUpdateReq) error { return u.svc.Update(req) }
+
+// LogUpdateCtx modifies an existing log entry using the given context.
+func (u *Unilog) LogUpdateCtx(ctx context.Context, req LogUpdateReq) error {
+	return u.svc.UpdateCtx(ctx, req)
+}
+
+// LogDelete removes a log entry.
+func (u *Unilog) LogDelete(req LogDeleteReq) error { return u.svc.Delete(req) }
+
+// LogDeleteCtx removes a log entry using the given context.
+func (u *Unilog) LogDeleteCtx(ctx context.Context, req LogDeleteReq) error {
+	return u.svc.DeleteCtx(ctx, req)
+}
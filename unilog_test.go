@@ -0,0 +1,63 @@
+// Copyright 2025 unilog Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unilog_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-the-way/unilog"
+)
+
+func TestNew(t *testing.T) {
+	st := unilog.NewMemoryStore()
+	u := unilog.New(unilog.WithRenderer(unilog.LogfmtRenderer{}), unilog.WithStore(st))
+	if u.GetStore() != st {
+		t.Fatal("GetStore() is not the Store set through WithStore")
+	}
+	if err := u.CallbackE()(order{1, "paid"}); err != nil {
+		t.Fatalf("CallbackE() = %v", err)
+	}
+	resp, err := u.LogGetPage(unilog.LogGetPageReq{})
+	if err != nil || len(resp.List) != 1 || resp.List[0].Content != "order{id=1 status=paid}" {
+		t.Fatalf("LogGetPage() = %+v, %v, want one entry rendered as logfmt", resp, err)
+	}
+	if unilog.GetStore() == st {
+		t.Fatal("WithStore changed the Store of the default instance")
+	}
+}
+
+func TestNewErrorHandler(t *testing.T) {
+	var handled int
+	u := unilog.New(unilog.WithErrorHandler(func(err error, req unilog.LogAddReq) {
+		if errors.Is(err, errStore) {
+			handled++
+		}
+	}), unilog.WithStore(failingStore{}))
+	u.Callback()(order{1, "paid"})
+	if handled != 1 {
+		t.Fatalf("error handler called %d times, want 1", handled)
+	}
+
+	u.SetErrorHandler(nil)
+	u.Callback()(order{2, "paid"})
+	if handled != 1 {
+		t.Fatalf("error handler called %d times after SetErrorHandler(nil), want 1", handled)
+	}
+}
+
+func TestNewDefaultStore(t *testing.T) {
+	u := unilog.New()
+	if _, err := u.LogGetPage(unilog.LogGetPageReq{}); !errors.Is(err, unilog.ErrNoDB) {
+		t.Fatalf("LogGetPage() without a database = %v, want %v", err, unilog.ErrNoDB)
+	}
+}
@@ -26,6 +26,8 @@ var (
 	AutoMigrate = db.AutoMigrate
 	// SetPagination configures the pagination function used for querying log entries.
 	SetPagination = db.SetPagination
+	// ContextWithTx returns a copy of the context carrying a database transaction, which log entries are written inside.
+	ContextWithTx = db.ContextWithTx
 )
 
 // Package-level variables for logger configuration.
@@ -33,16 +35,53 @@ var (
 var (
 	// GetFields extracts loggable fields from a struct for logging purposes.
 	GetFields = logger.GetFields
+	// GetDiff extracts only the changed fields between two structs of the same type for logging purposes.
+	GetDiff = logger.GetDiff
+	// Validate checks the log tags of a struct type and returns a ValidationError listing all problems.
+	Validate = logger.Validate
+	// CheckTag checks a single log tag and returns a description of each problem found, for static analysis tools.
+	CheckTag = logger.CheckTag
+	// RegisterMasker registers a custom masker under a name, to be referenced by the "mask:<name>" tag option.
+	RegisterMasker = logger.RegisterMasker
+	// KeepMasker creates a Masker that keeps the first and last characters of a value and masks the rest.
+	KeepMasker = logger.KeepMasker
+	// SetHashKey sets the secret key of the keyed "hash:hmac-<algorithm>" tag option.
+	SetHashKey = logger.SetHashKey
+	// SetRedactionPolicy sets the name patterns of the fields and map keys that are always redacted.
+	SetRedactionPolicy = logger.SetRedactionPolicy
+	// RegisterTransform registers a transform function under a name, to be referenced by the "transform:@<name>" tag option.
+	RegisterTransform = logger.RegisterTransform
+	// RegisterTypeFormatter registers a formatter for a type, whose values are then logged as single values.
+	RegisterTypeFormatter = logger.RegisterTypeFormatter
+	// SetNilPolicy sets how nil pointers and nil interfaces are logged.
+	SetNilPolicy = logger.SetNilPolicy
 	// SetArrayFunc sets a custom function for formatting array/slice values in logs.
 	SetArrayFunc = logger.SetArrayFunc
 	// SetMapFunc sets a custom function for formatting map values in logs.
 	SetMapFunc = logger.SetMapFunc
+	// SetMapKeyComparator sets a custom comparator for ordering map keys, or restores the natural ordering if nil.
+	SetMapKeyComparator = logger.SetMapKeyComparator
 	// SetFieldFormat sets a custom format string for logging fields.
 	SetFieldFormat = logger.SetFieldFormat
 	// SetArrayElementFormat sets a custom format string for array/slice elements in logs.
 	SetArrayElementFormat = logger.SetArrayElementFormat
 	// SetFieldJoinSep sets a custom separator for joining multiple field log strings.
 	SetFieldJoinSep = logger.SetFieldJoinSep
+	// SetDiffFormat sets a custom format string for logging changed fields.
+	SetDiffFormat = logger.SetDiffFormat
+)
+
+// Package-level variables for request-scoped logging data.
+// These aliases provide access to functions for carrying user data and client IP in a context.
+var (
+	// ContextWithUserdata returns a copy of the context carrying the given user data.
+	ContextWithUserdata = logger.ContextWithUserdata
+	// ContextWithClientIP returns a copy of the context carrying the given client IP address.
+	ContextWithClientIP = logger.ContextWithClientIP
+	// SetUserdataFunc sets a custom function for retrieving user data from a context.
+	SetUserdataFunc = logger.SetUserdataFunc
+	// SetClientIPFunc sets a custom function for retrieving the client IP address from a context.
+	SetClientIPFunc = logger.SetClientIPFunc
 )
 
 // Package-level variables for log service operations.
@@ -50,12 +89,83 @@ var (
 var (
 	// LogGetPage retrieves a paginated list of log entries.
 	LogGetPage = log.GetPage
+	// LogGetPageCtx retrieves a paginated list of log entries using the given context.
+	LogGetPageCtx = log.GetPageCtx
 	// LogGet retrieves a single log entry by its identifier or criteria.
 	LogGet = log.Get
+	// LogGetCtx retrieves a single log entry by its identifier or criteria using the given context.
+	LogGetCtx = log.GetCtx
 	// LogAdd creates a new log entry in the database.
 	LogAdd = log.Add
+	// LogAddCtx creates a new log entry in the database using the given context.
+	LogAddCtx = log.AddCtx
+	// LogAddTx creates a new log entry inside the given database transaction.
+	// The callback of the request runs before the transaction commits, even if it is rolled back later.
+	// Returns ErrNilTx if tx is nil.
+	LogAddTx = log.AddTx
+	// LogAddBatch creates multiple log entries in the database in batches, returning a *LogBatchError on failure.
+	LogAddBatch = log.AddBatch
+	// LogAddBatchCtx creates multiple log entries in the database in batches using the given context.
+	LogAddBatchCtx = log.AddBatchCtx
 	// LogUpdate modifies an existing log entry in the database.
 	LogUpdate = log.Update
+	// LogUpdateCtx modifies an existing log entry in the database using the given context.
+	LogUpdateCtx = log.UpdateCtx
 	// LogDelete removes a log entry from the database.
 	LogDelete = log.Delete
+	// LogDeleteCtx removes a log entry from the database using the given context.
+	LogDeleteCtx = log.DeleteCtx
+	// SetErrorHandler sets the handler invoked when a log entry fails to be added without a caller to return the error to.
+	SetErrorHandler = log.SetErrorHandler
+)
+
+// Package-level variables for log storage configuration.
+// These aliases provide access to functions for choosing where log entries are persisted.
+var (
+	// SetStore sets the Store used for persisting log entries, defaulting to the gorm database set through SetDB.
+	SetStore = log.SetStore
+	// GetStore retrieves the Store used for persisting log entries.
+	GetStore = log.GetStore
+	// NewGormStore creates a Store backed by the given gorm database, or the global one if nil.
+	NewGormStore = log.NewGormStore
+	// NewMemoryStore creates a thread-safe Store keeping log entries in memory, intended for tests and local development.
+	NewMemoryStore = log.NewMemoryStore
+	// ErrNoDB is returned by a gorm Store when it has no database and no global database is set through SetDB.
+	ErrNoDB = log.ErrNoDB
+	// ErrNilTx is returned by LogAddTx for a nil transaction.
+	ErrNilTx = log.ErrNilTx
+)
+
+// Package-level variables for asynchronous logging.
+// These aliases provide access to the asynchronous writer used by LogAdd.
+var (
+	// StartAsync starts an asynchronous writer and makes LogAdd enqueue log entries to it.
+	StartAsync = log.StartAsync
+	// ErrAsyncClosed is returned when adding a log entry to a closed asynchronous writer.
+	ErrAsyncClosed = log.ErrAsyncClosed
+	// ErrAsyncQueueFull is reported when a log entry is dropped because the asynchronous queue is full.
+	ErrAsyncQueueFull = log.ErrAsyncQueueFull
+)
+
+// Overflow policies for the asynchronous writer.
+const (
+	// OverflowBlock waits until the queue has room for the new log entry.
+	OverflowBlock = log.OverflowBlock
+	// OverflowDropNewest drops the new log entry.
+	OverflowDropNewest = log.OverflowDropNewest
+	// OverflowDropOldest drops the oldest queued log entry to make room for the new one.
+	OverflowDropOldest = log.OverflowDropOldest
+)
+
+// Redacted is the text logged in place of a redacted value.
+const Redacted = logger.Redacted
+
+// Nil policies for nil pointers and nil interfaces.
+const (
+	// NilRender logs nil values as "<nil>", or null in JSON. This is the default.
+	NilRender = logger.NilRender
+	// NilSkip leaves out nil values.
+	NilSkip = logger.NilSkip
+	// NilEmpty logs nil values as an empty string.
+	NilEmpty = logger.NilEmpty
 )